@@ -110,11 +110,16 @@ func main() {
 		logger.With(log, "component", promExporter),
 		metrics.NewNodeSuccess(logger.With(log, "component", promMetrics)),
 	)
+	offchainConfigFactory := exporter.NewOffchainConfigFactory(
+		logger.With(log, "component", promExporter),
+		metrics.NewOffchainConfig(logger.With(log, "component", promMetrics)),
+	)
 	monitor.ExporterFactories = append(monitor.ExporterFactories,
 		feedBalancesExporterFactory,
 		reportObservationsFactory,
 		feesFactory,
 		nodeSuccessFactory,
+		offchainConfigFactory,
 	)
 
 	// network exporters
@@ -13,6 +13,8 @@ import (
 type ChainReader interface {
 	GetState(ctx context.Context, account solana.PublicKey, commitment rpc.CommitmentType) (state pkgSolana.State, blockHeight uint64, err error)
 	GetLatestTransmission(ctx context.Context, account solana.PublicKey, commitment rpc.CommitmentType) (answer pkgSolana.Answer, blockHeight uint64, err error)
+	GetRoundData(ctx context.Context, account solana.PublicKey, roundID uint32, commitment rpc.CommitmentType) (round pkgSolana.RoundData, blockHeight uint64, err error)
+	GetTransmissionsRange(ctx context.Context, account solana.PublicKey, fromRoundID, toRoundID uint32, commitment rpc.CommitmentType) (rounds []pkgSolana.RoundData, blockHeight uint64, err error)
 
 	GetTokenAccountBalance(ctx context.Context, account solana.PublicKey, commitment rpc.CommitmentType) (out *rpc.GetTokenAccountBalanceResult, err error)
 	GetBalance(ctx context.Context, account solana.PublicKey, commitment rpc.CommitmentType) (out *rpc.GetBalanceResult, err error)
@@ -38,6 +40,14 @@ func (c *chainReader) GetLatestTransmission(ctx context.Context, account solana.
 	return pkgSolana.GetLatestTransmission(ctx, c.client, account, commitment)
 }
 
+func (c *chainReader) GetRoundData(ctx context.Context, account solana.PublicKey, roundID uint32, commitment rpc.CommitmentType) (round pkgSolana.RoundData, blockHeight uint64, err error) {
+	return pkgSolana.GetRoundData(ctx, c.client, account, roundID, commitment)
+}
+
+func (c *chainReader) GetTransmissionsRange(ctx context.Context, account solana.PublicKey, fromRoundID, toRoundID uint32, commitment rpc.CommitmentType) (rounds []pkgSolana.RoundData, blockHeight uint64, err error) {
+	return pkgSolana.GetTransmissionsRange(ctx, c.client, account, fromRoundID, toRoundID, commitment)
+}
+
 func (c *chainReader) GetTokenAccountBalance(ctx context.Context, account solana.PublicKey, commitment rpc.CommitmentType) (out *rpc.GetTokenAccountBalanceResult, err error) {
 	return c.client.GetTokenAccountBalance(ctx, account, commitment)
 }
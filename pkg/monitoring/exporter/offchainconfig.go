@@ -0,0 +1,70 @@
+package exporter
+
+import (
+	"context"
+
+	commonMonitoring "github.com/smartcontractkit/chainlink-common/pkg/monitoring"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/metrics"
+	pkgSolana "github.com/smartcontractkit/chainlink-solana/pkg/solana"
+)
+
+func NewOffchainConfigFactory(
+	log commonMonitoring.Logger,
+	metrics metrics.OffchainConfig,
+) commonMonitoring.ExporterFactory {
+	return &offchainConfigFactory{
+		log,
+		metrics,
+	}
+}
+
+type offchainConfigFactory struct {
+	log     commonMonitoring.Logger
+	metrics metrics.OffchainConfig
+}
+
+func (p *offchainConfigFactory) NewExporter(
+	params commonMonitoring.ExporterParams,
+) (commonMonitoring.Exporter, error) {
+	return &offchainConfig{
+		metrics.FeedInput{
+			AccountAddress: params.FeedConfig.GetContractAddress(),
+			FeedID:         params.FeedConfig.GetContractAddress(),
+			ChainID:        params.ChainConfig.GetChainID(),
+			ContractStatus: params.FeedConfig.GetContractStatus(),
+			ContractType:   params.FeedConfig.GetContractType(),
+			FeedName:       params.FeedConfig.GetName(),
+			FeedPath:       params.FeedConfig.GetPath(),
+			NetworkID:      params.ChainConfig.GetNetworkID(),
+			NetworkName:    params.ChainConfig.GetNetworkName(),
+		},
+		p.log,
+		p.metrics,
+	}, nil
+}
+
+// offchainConfig exports the decoded offchain config of the envelope
+type offchainConfig struct {
+	label   metrics.FeedInput // static for each feed
+	log     commonMonitoring.Logger
+	metrics metrics.OffchainConfig
+}
+
+func (p *offchainConfig) Export(ctx context.Context, data interface{}) {
+	envelope, ok := data.(commonMonitoring.Envelope)
+	if !ok {
+		return // skip if input could not be parsed
+	}
+
+	config, err := pkgSolana.DecodeOffchainConfigData(envelope.ContractConfig.OffchainConfigVersion, envelope.ContractConfig.OffchainConfig)
+	if err != nil {
+		p.log.Errorw("failed to decode offchain config", "feed", p.label.ToPromLabels(), "err", err)
+		return
+	}
+	p.metrics.Set(config, p.label)
+}
+
+func (p *offchainConfig) Cleanup(_ context.Context) {
+	p.metrics.Cleanup(p.label)
+}
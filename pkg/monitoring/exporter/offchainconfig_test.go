@@ -0,0 +1,58 @@
+package exporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	commonMonitoring "github.com/smartcontractkit/chainlink-common/pkg/monitoring"
+	"github.com/smartcontractkit/chainlink-common/pkg/monitoring/pb"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/metrics/mocks"
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/testutils"
+	pkgSolana "github.com/smartcontractkit/chainlink-solana/pkg/solana"
+)
+
+func TestOffchainConfig(t *testing.T) {
+	ctx := tests.Context(t)
+	lgr, logs := logger.TestObserved(t, zapcore.ErrorLevel)
+	m := mocks.NewOffchainConfig(t)
+
+	factory := NewOffchainConfigFactory(lgr, m)
+
+	chainConfig := testutils.GenerateChainConfig()
+	feedConfig := testutils.GenerateFeedConfig()
+	exporter, err := factory.NewExporter(commonMonitoring.ExporterParams{ChainConfig: chainConfig, FeedConfig: feedConfig, Nodes: []commonMonitoring.NodeConfig{}})
+	require.NoError(t, err)
+
+	medianConfig := median.OffchainConfig{AlphaReportPPB: 5_000_000, DeltaC: time.Hour}
+	offchainConfig, err := proto.Marshal(&pb.OffchainConfigProto{
+		DeltaProgressNanoseconds: uint64(10 * time.Second),
+		ReportingPluginConfig:    medianConfig.Encode(),
+	})
+	require.NoError(t, err)
+
+	// happy path
+	m.On("Set", pkgSolana.DecodedOffchainConfig{DeltaProgress: 10 * time.Second, Median: medianConfig}, mock.Anything).Once()
+	m.On("Cleanup", mock.Anything).Once()
+	envelope := commonMonitoring.Envelope{}
+	envelope.ContractConfig.OffchainConfigVersion = 2
+	envelope.ContractConfig.OffchainConfig = offchainConfig
+	exporter.Export(ctx, envelope)
+	exporter.Cleanup(ctx)
+
+	// not envelope type - no calls to mock
+	assert.NotPanics(t, func() { exporter.Export(ctx, 1) })
+
+	// invalid offchain config - no calls to mock
+	exporter.Export(ctx, commonMonitoring.Envelope{})
+	assert.Equal(t, 1, logs.FilterMessage("failed to decode offchain config").Len())
+}
@@ -99,6 +99,14 @@ func init() {
 			"chain",
 		},
 	)
+
+	// init gauge for the decoded offchain config per feed
+	gauges[types.OffchainConfigMetric] = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: types.OffchainConfigMetric,
+		},
+		append([]string{"parameter"}, feedLabels...),
+	)
 }
 
 type FeedInput struct {
@@ -0,0 +1,91 @@
+package metrics
+
+import (
+	"math"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	commonMonitoring "github.com/smartcontractkit/chainlink-common/pkg/monitoring"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/types"
+	pkgSolana "github.com/smartcontractkit/chainlink-solana/pkg/solana"
+)
+
+//go:generate mockery --name OffchainConfig --output ./mocks/
+
+type OffchainConfig interface {
+	Set(config pkgSolana.DecodedOffchainConfig, feedInput FeedInput)
+	Cleanup(feedInput FeedInput)
+}
+
+var _ OffchainConfig = (*offchainConfig)(nil)
+
+// OffchainConfigParameters are the values of the "parameter" label. Durations are in seconds.
+var OffchainConfigParameters = []string{
+	"delta_progress",
+	"delta_resend",
+	"delta_round",
+	"delta_grace",
+	"delta_stage",
+	"r_max",
+	"n",
+	"max_duration_query",
+	"max_duration_observation",
+	"max_duration_report",
+	"max_duration_should_accept_finalized_report",
+	"max_duration_should_transmit_accepted_report",
+	"alpha_report_ppb", // +Inf if the deviation check never triggers a report
+	"alpha_accept_ppb", // +Inf if the deviation check never accepts a report
+	"delta_c",
+}
+
+type offchainConfig struct {
+	simpleGauge
+}
+
+func NewOffchainConfig(log commonMonitoring.Logger) *offchainConfig {
+	return &offchainConfig{newSimpleGauge(log, types.OffchainConfigMetric)}
+}
+
+func (oc *offchainConfig) Set(config pkgSolana.DecodedOffchainConfig, feedInput FeedInput) {
+	alphaReport, alphaAccept := float64(config.Median.AlphaReportPPB), float64(config.Median.AlphaAcceptPPB)
+	if config.Median.AlphaReportInfinite {
+		alphaReport = math.Inf(1)
+	}
+	if config.Median.AlphaAcceptInfinite {
+		alphaAccept = math.Inf(1)
+	}
+
+	values := []float64{
+		config.DeltaProgress.Seconds(),
+		config.DeltaResend.Seconds(),
+		config.DeltaRound.Seconds(),
+		config.DeltaGrace.Seconds(),
+		config.DeltaStage.Seconds(),
+		float64(config.RMax),
+		float64(len(config.PeerIDs)),
+		config.MaxDurationQuery.Seconds(),
+		config.MaxDurationObservation.Seconds(),
+		config.MaxDurationReport.Seconds(),
+		config.MaxDurationShouldAcceptFinalizedReport.Seconds(),
+		config.MaxDurationShouldTransmitAcceptedReport.Seconds(),
+		alphaReport,
+		alphaAccept,
+		config.Median.DeltaC.Seconds(),
+	}
+	for i, parameter := range OffchainConfigParameters {
+		oc.set(values[i], oc.labels(parameter, feedInput))
+	}
+}
+
+func (oc *offchainConfig) Cleanup(feedInput FeedInput) {
+	for _, parameter := range OffchainConfigParameters {
+		oc.delete(oc.labels(parameter, feedInput))
+	}
+}
+
+func (oc *offchainConfig) labels(parameter string, feedInput FeedInput) prometheus.Labels {
+	l := feedInput.ToPromLabels()
+	l["parameter"] = parameter
+	return l
+}
@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/types"
+	pkgSolana "github.com/smartcontractkit/chainlink-solana/pkg/solana"
+)
+
+func TestOffchainConfig(t *testing.T) {
+	lgr := logger.Test(t)
+	m := NewOffchainConfig(lgr)
+
+	// fetching gauges
+	g, ok := gauges[types.OffchainConfigMetric]
+	require.True(t, ok)
+
+	config := pkgSolana.DecodedOffchainConfig{
+		DeltaProgress: 10 * time.Second,
+		RMax:          3,
+		PeerIDs:       []string{"peer1", "peer2"},
+		Median: median.OffchainConfig{
+			AlphaReportInfinite: true,
+			AlphaAcceptPPB:      1_000_000,
+			DeltaC:              time.Hour,
+		},
+	}
+	inputs := FeedInput{NetworkName: t.Name()}
+	value := func(parameter string) float64 {
+		return testutil.ToFloat64(g.With(m.labels(parameter, inputs)))
+	}
+
+	// set gauge
+	assert.NotPanics(t, func() { m.Set(config, inputs) })
+	assert.Equal(t, float64(10), value("delta_progress"))
+	assert.Equal(t, float64(3), value("r_max"))
+	assert.Equal(t, float64(2), value("n"))
+	assert.Equal(t, math.Inf(1), value("alpha_report_ppb"))
+	assert.Equal(t, float64(1_000_000), value("alpha_accept_ppb"))
+	assert.Equal(t, float64(3600), value("delta_c"))
+
+	// cleanup gauges
+	assert.Equal(t, len(OffchainConfigParameters), testutil.CollectAndCount(g))
+	assert.NotPanics(t, func() { m.Cleanup(inputs) })
+	assert.Equal(t, 0, testutil.CollectAndCount(g))
+}
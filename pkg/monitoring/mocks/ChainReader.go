@@ -114,6 +114,41 @@ func (_m *ChainReader) GetLatestTransmission(ctx context.Context, account solana
 	return r0, r1, r2
 }
 
+// GetRoundData provides a mock function with given fields: ctx, account, roundID, commitment
+func (_m *ChainReader) GetRoundData(ctx context.Context, account solana.PublicKey, roundID uint32, commitment rpc.CommitmentType) (pkgsolana.RoundData, uint64, error) {
+	ret := _m.Called(ctx, account, roundID, commitment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoundData")
+	}
+
+	var r0 pkgsolana.RoundData
+	var r1 uint64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, solana.PublicKey, uint32, rpc.CommitmentType) (pkgsolana.RoundData, uint64, error)); ok {
+		return rf(ctx, account, roundID, commitment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, solana.PublicKey, uint32, rpc.CommitmentType) pkgsolana.RoundData); ok {
+		r0 = rf(ctx, account, roundID, commitment)
+	} else {
+		r0 = ret.Get(0).(pkgsolana.RoundData)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, solana.PublicKey, uint32, rpc.CommitmentType) uint64); ok {
+		r1 = rf(ctx, account, roundID, commitment)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, solana.PublicKey, uint32, rpc.CommitmentType) error); ok {
+		r2 = rf(ctx, account, roundID, commitment)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetSignaturesForAddressWithOpts provides a mock function with given fields: ctx, account, opts
 func (_m *ChainReader) GetSignaturesForAddressWithOpts(ctx context.Context, account solana.PublicKey, opts *rpc.GetSignaturesForAddressOpts) ([]*rpc.TransactionSignature, error) {
 	ret := _m.Called(ctx, account, opts)
@@ -267,6 +302,43 @@ func (_m *ChainReader) GetTransaction(ctx context.Context, txSig solana.Signatur
 	return r0, r1
 }
 
+// GetTransmissionsRange provides a mock function with given fields: ctx, account, fromRoundID, toRoundID, commitment
+func (_m *ChainReader) GetTransmissionsRange(ctx context.Context, account solana.PublicKey, fromRoundID uint32, toRoundID uint32, commitment rpc.CommitmentType) ([]pkgsolana.RoundData, uint64, error) {
+	ret := _m.Called(ctx, account, fromRoundID, toRoundID, commitment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransmissionsRange")
+	}
+
+	var r0 []pkgsolana.RoundData
+	var r1 uint64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, solana.PublicKey, uint32, uint32, rpc.CommitmentType) ([]pkgsolana.RoundData, uint64, error)); ok {
+		return rf(ctx, account, fromRoundID, toRoundID, commitment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, solana.PublicKey, uint32, uint32, rpc.CommitmentType) []pkgsolana.RoundData); ok {
+		r0 = rf(ctx, account, fromRoundID, toRoundID, commitment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pkgsolana.RoundData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, solana.PublicKey, uint32, uint32, rpc.CommitmentType) uint64); ok {
+		r1 = rf(ctx, account, fromRoundID, toRoundID, commitment)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, solana.PublicKey, uint32, uint32, rpc.CommitmentType) error); ok {
+		r2 = rf(ctx, account, fromRoundID, toRoundID, commitment)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // NewChainReader creates a new instance of ChainReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewChainReader(t interface {
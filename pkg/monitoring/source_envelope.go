@@ -15,8 +15,9 @@ import (
 	commonMonitoring "github.com/smartcontractkit/chainlink-common/pkg/monitoring"
 
 	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/config"
-	"github.com/smartcontractkit/chainlink-solana/pkg/monitoring/event"
 	pkgSolana "github.com/smartcontractkit/chainlink-solana/pkg/solana"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/event"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/monitor"
 )
 
 func NewEnvelopeSourceFactory(
@@ -35,7 +36,7 @@ type envelopeSourceFactory struct {
 }
 
 func (s *envelopeSourceFactory) NewSource(
-	_ commonMonitoring.ChainConfig,
+	chainConfig commonMonitoring.ChainConfig,
 	feedConfig commonMonitoring.FeedConfig,
 ) (commonMonitoring.Source, error) {
 	solanaFeedConfig, ok := feedConfig.(config.SolanaFeedConfig)
@@ -44,6 +45,7 @@ func (s *envelopeSourceFactory) NewSource(
 	}
 	return &envelopeSource{
 		client:     s.client,
+		chainID:    chainConfig.GetChainID(),
 		feedConfig: solanaFeedConfig,
 		log:        s.log,
 	}, nil
@@ -55,6 +57,7 @@ func (s *envelopeSourceFactory) GetType() string {
 
 type envelopeSource struct {
 	client     ChainReader
+	chainID    string
 	feedConfig config.SolanaFeedConfig
 	log        commonMonitoring.Logger
 
@@ -87,6 +90,16 @@ func (s *envelopeSource) Fetch(ctx context.Context) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode ContractConfig from on-chain state: %w", err)
 	}
+	digester := pkgSolana.OffchainConfigDigester{
+		ProgramID: s.feedConfig.ContractAddress,
+		StateID:   s.feedConfig.StateAccount,
+	}
+	err = pkgSolana.VerifyConfig(ctx, digester, contractConfig)
+	monitor.SetConfigDigestMismatch(s.chainID, s.feedConfig.StateAccountBase58, errors.Is(err, pkgSolana.ErrConfigDigestMismatch))
+	if err != nil {
+		// the config is still reported, only the config tracker refuses it
+		s.log.Warnw("failed to verify ContractConfig from on-chain state", "state_account", s.feedConfig.StateAccountBase58, "err", err)
+	}
 	envelope := commonMonitoring.Envelope{
 		ConfigDigest: state.Config.LatestConfigDigest,
 		Epoch:        state.Config.Epoch,
@@ -231,20 +244,9 @@ func (s *envelopeSource) getJuelsPerLamport(ctx context.Context) (*big.Int, erro
 // Helpers
 
 func getLinkAvailableForPayment(state pkgSolana.State, linkBalance *big.Int) (*big.Int, error) {
-	oracles, err := state.Oracles.Data()
+	payments, err := pkgSolana.CalculatePayments(state, linkBalance)
 	if err != nil {
 		return nil, err
 	}
-	var countUnpaidRounds, reimbursements uint64 = 0, 0
-	for _, oracle := range oracles {
-		numRounds := uint32(0) // prevent overflow if RoundID is larger than latest aggregator RoundID
-		if state.Config.LatestAggregatorRoundID >= oracle.FromRoundID {
-			numRounds = state.Config.LatestAggregatorRoundID - oracle.FromRoundID
-		}
-		countUnpaidRounds += uint64(numRounds)
-		reimbursements += oracle.Payment
-	}
-	amountDue := uint64(state.Config.Billing.ObservationPayment)*countUnpaidRounds + reimbursements
-	remaining := new(big.Int).Sub(linkBalance, new(big.Int).SetUint64(amountDue))
-	return remaining, nil
+	return payments.LinkAvailable, nil
 }
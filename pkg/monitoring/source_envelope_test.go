@@ -126,13 +126,22 @@ func TestEnvelopeSource(t *testing.T) {
 	feedConfig.ContractAddressBase58 = "cjg3oHmg9uuPsP8D6g29NWvhySJkdYdAo9D25PRbKXJ"
 	feedConfig.ContractAddress = solana.MustPublicKeyFromBase58(feedConfig.ContractAddressBase58)
 
+	// The recorded digest was derived from another state account, recompute it for the generated one.
+	contractConfig, err := pkgSolana.ConfigFromState(tests.Context(t), fakeState)
+	require.NoError(t, err)
+	digester := pkgSolana.OffchainConfigDigester{ProgramID: feedConfig.ContractAddress, StateID: feedConfig.StateAccount}
+	digest, err := digester.ConfigDigest(tests.Context(t), contractConfig)
+	require.NoError(t, err)
+	state := fakeState
+	state.Config.LatestConfigDigest = digest
+
 	// Setup mocks
 	chainReader := mocks.NewChainReader(t)
 	chainReader.On("GetState",
 		mock.Anything, // ctx
 		feedConfig.StateAccount,
 		rpc.CommitmentConfirmed,
-	).Return(fakeState, fakeBlockNum, nil).Once()
+	).Return(state, fakeBlockNum, nil).Once()
 	chainReader.On("GetLatestTransmission",
 		mock.Anything, // ctx
 		fakeState.Transmissions,
@@ -169,13 +178,13 @@ func TestEnvelopeSource(t *testing.T) {
 
 	// Assertions
 	expectedEnvelope := commonMonitoring.Envelope{
-		ConfigDigest:    types.ConfigDigest{0x0, 0x3, 0x72, 0xfb, 0x1f, 0xcb, 0x20, 0x44, 0xeb, 0xb3, 0xb9, 0xda, 0xb, 0x49, 0x9c, 0xe3, 0xda, 0x6e, 0x38, 0x3d, 0xfe, 0x7d, 0x20, 0x3e, 0x32, 0x6b, 0xa7, 0x93, 0x68, 0xf9, 0x80, 0xa8},
+		ConfigDigest:    digest,
 		Epoch:           0x4f9ef,
 		Round:           0x3,
 		LatestAnswer:    big.NewInt(51268930158),
 		LatestTimestamp: time.Unix(int64(fakeAnswer.Timestamp), 0),
 		ContractConfig: types.ContractConfig{
-			ConfigDigest: types.ConfigDigest{0x0, 0x3, 0x72, 0xfb, 0x1f, 0xcb, 0x20, 0x44, 0xeb, 0xb3, 0xb9, 0xda, 0xb, 0x49, 0x9c, 0xe3, 0xda, 0x6e, 0x38, 0x3d, 0xfe, 0x7d, 0x20, 0x3e, 0x32, 0x6b, 0xa7, 0x93, 0x68, 0xf9, 0x80, 0xa8},
+			ConfigDigest: digest,
 			ConfigCount:  0x1,
 			Signers: []types.OnchainPublicKey{
 				{0x14, 0xdf, 0x25, 0x10, 0xa3, 0xa2, 0x51, 0xcc, 0x2d, 0x63, 0x13, 0x88, 0xf8, 0x9e, 0x79, 0x6e, 0x5c, 0x5d, 0xb6, 0xad},
@@ -242,6 +251,38 @@ func TestEnvelopeSource(t *testing.T) {
 		require.Equal(t, cacheValue, v.Uint64())
 		tests.AssertLogEventually(t, logs.FilterLevelExact(zapcore.WarnLevel), "no transactions found, falling back to cached value - history may have been pruned (cached_value=0 indicates pruned txs encountered on startup)")
 	})
+
+	t.Run("mismatched config digest is reported", func(t *testing.T) {
+		// the recorded digest does not match the generated state account
+		chainReader.On("GetState",
+			mock.Anything,
+			feedConfig.StateAccount,
+			rpc.CommitmentConfirmed,
+		).Return(fakeState, fakeBlockNum, nil).Once()
+		chainReader.On("GetLatestTransmission",
+			mock.Anything,
+			fakeState.Transmissions,
+			rpc.CommitmentConfirmed,
+		).Return(fakeAnswer, fakeBlockNum, nil).Once()
+		chainReader.On("GetTokenAccountBalance",
+			mock.Anything,
+			fakeState.Config.TokenVault,
+			rpc.CommitmentConfirmed,
+		).Return(fakeLinkBalanceRes, nil).Once()
+		chainReader.On("GetSignaturesForAddressWithOpts",
+			mock.Anything,
+			feedConfig.StateAccount,
+			mock.Anything,
+		).Return(fakeTxSignatures, nil).Once()
+
+		rawEnvelope, err := source.Fetch(tests.Context(t))
+		require.NoError(t, err)
+		envelope, ok := rawEnvelope.(commonMonitoring.Envelope)
+		require.True(t, ok)
+		require.Equal(t, types.ConfigDigest(fakeState.Config.LatestConfigDigest), envelope.ConfigDigest)
+		require.Equal(t, types.ConfigDigest(fakeState.Config.LatestConfigDigest), envelope.ContractConfig.ConfigDigest)
+		tests.AssertLogEventually(t, logs.FilterLevelExact(zapcore.WarnLevel), "failed to verify ContractConfig from on-chain state")
+	})
 }
 
 func TestGetLinkAvailableForPayment(t *testing.T) {
@@ -7,6 +7,9 @@ const (
 
 	NetworkFeesType   = "network_fees"
 	NetworkFeesMetric = "sol_" + NetworkFeesType
+
+	OffchainConfigType   = "offchain_config"
+	OffchainConfigMetric = "sol_" + OffchainConfigType
 )
 
 // SlotHeight type wraps the uint64 type returned by the RPC call
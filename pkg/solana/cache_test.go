@@ -4,15 +4,18 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"sync"
 	"testing"
 	"time"
 
+	bin "github.com/gagliardetto/binary"
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/stretchr/testify/assert"
@@ -147,6 +150,270 @@ func TestGetLatestTransmission(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestDecodeLatestTransmission(t *testing.T) {
+	a, err := DecodeLatestTransmission(mockTransmission)
+	require.NoError(t, err)
+	assert.Equal(t, expectedTime, a.Timestamp)
+	assert.Equal(t, expectedAns, a.Data.String())
+
+	// fail if the data is too short for the latest transmission
+	_, err = DecodeLatestTransmission(mockTransmission[:AccountDiscriminatorLen+TransmissionsHeaderMaxSize])
+	assert.Error(t, err)
+
+	// fail if the data is too short for the header
+	_, err = DecodeLatestTransmission(mockTransmission[:AccountDiscriminatorLen])
+	assert.Error(t, err)
+}
+
+func TestGetFeedSnapshot(t *testing.T) {
+	// the latest transmission is beyond the slice of the state length
+	transmissions := farTransmission(t)
+
+	var methods []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		var msg mockRequest
+		require.NoError(t, json.Unmarshal(body, &msg))
+		methods = append(methods, msg.Method)
+
+		opts := mockAccountOpts(t, msg)
+		switch msg.Method {
+		case "getMultipleAccounts":
+			state := base64.StdEncoding.EncodeToString(opts.slice(mockState.Raw))
+			transmissions := base64.StdEncoding.EncodeToString(opts.slice(transmissions))
+			res := fmt.Sprintf(`{"jsonrpc":"2.0","result":{"context": {"slot":7},"value": [{"data":["%s","base64"],"executable": false,"lamports": 1000000000,"owner": "11111111111111111111111111111111","rentEpoch":2},{"data":["%s","base64"],"executable": false,"lamports": 1000000000,"owner": "11111111111111111111111111111111","rentEpoch":2}]},"id":1}`, state, transmissions)
+			_, err = w.Write([]byte(res))
+		default:
+			// the transmission is read at the slot of the header or later
+			assert.Equal(t, uint64(7), opts.MinContextSlot)
+			transmission := base64.StdEncoding.EncodeToString(opts.slice(transmissions))
+			res := fmt.Sprintf(`{"jsonrpc":"2.0","result":{"context": {"slot":8},"value": {"data":["%s","base64"],"executable": false,"lamports": 1000000000,"owner": "11111111111111111111111111111111","rentEpoch":2}},"id":1}`, transmission)
+			_, err = w.Write([]byte(res))
+		}
+		require.NoError(t, err)
+	}))
+	defer mockServer.Close()
+
+	reader := testSetupReader(t, mockServer.URL)
+	transmissionsID := solana.MustPublicKeyFromBase58("GADeYvXjPwZP7ds1yDY9VFp12bNjdxT1YyksMvFGK9xn")
+
+	snapshot, err := GetFeedSnapshot(tests.Context(t), reader, solana.PublicKey{}, transmissionsID, "")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), snapshot.Slot)
+	assert.Equal(t, mockState.ConfigDigestHex, hex.EncodeToString(snapshot.State.Config.LatestConfigDigest[:]))
+	assert.Equal(t, expectedTime, snapshot.Answer.Timestamp)
+	assert.Equal(t, expectedAns, snapshot.Answer.Data.String())
+	assert.Equal(t, []string{"getMultipleAccounts", "getAccountInfo"}, methods)
+
+	// fail if the state does not store transmissions in the account
+	_, err = GetFeedSnapshot(tests.Context(t), reader, solana.PublicKey{}, solana.PublicKey{}, "")
+	assert.ErrorContains(t, err, "stores transmissions in")
+}
+
+func TestStateLen(t *testing.T) {
+	data, err := bin.MarshalBorsh(State{})
+	require.NoError(t, err)
+	assert.Equal(t, StateLen, uint64(len(data)))
+}
+
+// farTransmission returns a transmissions account with the latest transmission of mockTransmission at the
+// end of a live ring buffer that is longer than the state account.
+func farTransmission(t *testing.T) []byte {
+	header, err := decodeTransmissionsHeader(mockTransmission[AccountDiscriminatorLen : AccountDiscriminatorLen+TransmissionsHeaderLen])
+	require.NoError(t, err)
+	latest := latestTransmissionOffset(header)
+
+	header.LiveLength = 200
+	header.LiveCursor = 0
+	encoded, err := bin.MarshalBorsh(header)
+	require.NoError(t, err)
+
+	data := make([]byte, AccountDiscriminatorLen+TransmissionsHeaderMaxSize+uint64(header.LiveLength)*TransmissionLen)
+	copy(data, mockTransmission[:AccountDiscriminatorLen])
+	copy(data[AccountDiscriminatorLen:], encoded)
+
+	offset := latestTransmissionOffset(header)
+	require.Greater(t, offset, StateLen)
+	copy(data[offset:], mockTransmission[latest:latest+TransmissionLen])
+	return data
+}
+
+type mockAccountOptions struct {
+	DataSlice *struct {
+		Offset uint64
+		Length uint64
+	}
+	MinContextSlot uint64
+}
+
+// mockAccountOpts parses the options of a getAccountInfo or getMultipleAccounts request.
+func mockAccountOpts(t *testing.T, msg mockRequest) mockAccountOptions {
+	var opts mockAccountOptions
+	if len(msg.Params) > 1 {
+		require.NoError(t, json.Unmarshal(msg.Params[1], &opts))
+	}
+	return opts
+}
+
+func (o mockAccountOptions) slice(data []byte) []byte {
+	if o.DataSlice == nil {
+		return data
+	}
+	start := min(o.DataSlice.Offset, uint64(len(data)))
+	return data[start:min(start+o.DataSlice.Length, uint64(len(data)))]
+}
+
+func TestFeedCache(t *testing.T) {
+	ctx := tests.Context(t)
+	transmissionsID := solana.MustPublicKeyFromBase58("GADeYvXjPwZP7ds1yDY9VFp12bNjdxT1YyksMvFGK9xn")
+
+	t.Run("polled", func(t *testing.T) {
+		var methods []string
+		var lock sync.Mutex
+		mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+
+			var msg mockRequest
+			require.NoError(t, json.Unmarshal(body, &msg))
+			lock.Lock()
+			methods = append(methods, msg.Method)
+			lock.Unlock()
+
+			state := base64.StdEncoding.EncodeToString(mockState.Raw)
+			transmissions := base64.StdEncoding.EncodeToString(mockTransmission)
+			res := fmt.Sprintf(`{"jsonrpc":"2.0","result":{"context": {"slot":7},"value": [{"data":["%s","base64"],"executable": false,"lamports": 1000000000,"owner": "11111111111111111111111111111111","rentEpoch":2},{"data":["%s","base64"],"executable": false,"lamports": 1000000000,"owner": "11111111111111111111111111111111","rentEpoch":2}]},"id":1}`, state, transmissions)
+			_, _ = w.Write([]byte(res))
+		}))
+		defer mockServer.Close()
+
+		reader := testSetupReader(t, mockServer.URL)
+		stateCache := NewStateCache(solana.PublicKey{}, "test-chain-id", config.NewDefault(), reader, logger.Test(t))
+		feedCache := NewFeedCache(stateCache, transmissionsID, "test-chain-id", config.NewDefault(), reader, logger.Test(t))
+		require.NoError(t, feedCache.Start(ctx))
+		require.NoError(t, feedCache.HealthReport()[feedCache.Name()])
+		require.NoError(t, feedCache.Close())
+		require.Error(t, feedCache.HealthReport()[feedCache.Name()])
+
+		snapshot, err := feedCache.Read(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(7), snapshot.Slot)
+		assert.Equal(t, expectedAns, snapshot.Answer.Data.String())
+
+		// the state is stored in the state cache, which does not read the account on its own
+		state, slot, err := stateCache.ReadWithSlot()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(7), slot)
+		assert.Equal(t, transmissionsID, state.Transmissions)
+
+		lock.Lock()
+		defer lock.Unlock()
+		assert.NotContains(t, methods, "getAccountInfo")
+	})
+
+	t.Run("subscribed", func(t *testing.T) {
+		cfg := config.NewDefault()
+		reader := &feedAccountsReader{accounts: map[solana.PublicKey]feedAccount{
+			solana.PublicKey{}: {slot: 8, data: mockState.Raw},
+			transmissionsID:    {slot: 8, data: mockTransmission},
+		}}
+		// the websocket is not available, so the subscriptions poll the reader
+		subscriptions := client.NewAccountSubscriptions("ws://127.0.0.1:0", reader, cfg, logger.Test(t))
+		require.NoError(t, subscriptions.Start(ctx))
+		t.Cleanup(func() { require.NoError(t, subscriptions.Close()) })
+
+		stateCache := NewSubscribedStateCache(solana.PublicKey{}, "test-chain-id", cfg, subscriptions, logger.Test(t))
+		feedCache := NewSubscribedFeedCache(stateCache, transmissionsID, "test-chain-id", cfg, subscriptions, reader, logger.Test(t))
+		require.NoError(t, feedCache.Start(ctx))
+		t.Cleanup(func() { require.NoError(t, feedCache.Close()) })
+		require.NoError(t, feedCache.HealthReport()[feedCache.Name()])
+
+		// both accounts were updated by the same transmission
+		snapshot, err := feedCache.Read(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(8), snapshot.Slot)
+		assert.Equal(t, transmissionsID, snapshot.State.Transmissions)
+		assert.Equal(t, expectedAns, snapshot.Answer.Data.String())
+		assert.Equal(t, 0, reader.multipleReads())
+	})
+
+	t.Run("subscribed at different slots", func(t *testing.T) {
+		cfg := config.NewDefault()
+		reader := &feedAccountsReader{accounts: map[solana.PublicKey]feedAccount{
+			solana.PublicKey{}: {slot: 9, data: mockState.Raw},
+			transmissionsID:    {slot: 8, data: mockTransmission},
+		}}
+		subscriptions := client.NewAccountSubscriptions("ws://127.0.0.1:0", reader, cfg, logger.Test(t))
+		require.NoError(t, subscriptions.Start(ctx))
+		t.Cleanup(func() { require.NoError(t, subscriptions.Close()) })
+
+		stateCache := NewSubscribedStateCache(solana.PublicKey{}, "test-chain-id", cfg, subscriptions, logger.Test(t))
+		feedCache := NewSubscribedFeedCache(stateCache, transmissionsID, "test-chain-id", cfg, subscriptions, reader, logger.Test(t))
+		require.NoError(t, feedCache.Start(ctx))
+		t.Cleanup(func() { require.NoError(t, feedCache.Close()) })
+
+		// the accounts are read together instead of combining updates of different slots
+		snapshot, err := feedCache.Read(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(9), snapshot.Slot)
+		assert.Equal(t, expectedAns, snapshot.Answer.Data.String())
+		assert.Equal(t, 1, reader.multipleReads())
+
+		// the snapshot is kept until either account is updated
+		_, err = feedCache.Read(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, reader.multipleReads())
+	})
+}
+
+type feedAccount struct {
+	slot uint64
+	data []byte
+}
+
+type feedAccountsReader struct {
+	accounts map[solana.PublicKey]feedAccount
+
+	lock     sync.Mutex
+	multiple int
+}
+
+func (r *feedAccountsReader) GetAccountInfoWithOpts(_ context.Context, account solana.PublicKey, _ *rpc.GetAccountInfoOpts) (*rpc.GetAccountInfoResult, error) {
+	res, ok := r.accounts[account]
+	if !ok {
+		return nil, fmt.Errorf("unknown account %s", account)
+	}
+	return &rpc.GetAccountInfoResult{
+		RPCContext: rpc.RPCContext{Context: rpc.Context{Slot: res.slot}},
+		Value:      &rpc.Account{Data: rpc.DataBytesOrJSONFromBytes(res.data)},
+	}, nil
+}
+
+func (r *feedAccountsReader) GetMultipleAccountsWithOpts(_ context.Context, accounts []solana.PublicKey, _ *rpc.GetMultipleAccountsOpts) (*rpc.GetMultipleAccountsResult, error) {
+	r.lock.Lock()
+	r.multiple++
+	r.lock.Unlock()
+
+	res := &rpc.GetMultipleAccountsResult{}
+	for _, account := range accounts {
+		acc, ok := r.accounts[account]
+		if !ok {
+			return nil, fmt.Errorf("unknown account %s", account)
+		}
+		res.RPCContext.Context.Slot = max(res.RPCContext.Context.Slot, acc.slot)
+		res.Value = append(res.Value, &rpc.Account{Data: rpc.DataBytesOrJSONFromBytes(acc.data)})
+	}
+	return res, nil
+}
+
+func (r *feedAccountsReader) multipleReads() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.multiple
+}
+
 func TestCache(t *testing.T) {
 	ctx := tests.Context(t)
 	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -181,23 +448,43 @@ func TestCache(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "GADeYvXjPwZP7ds1yDY9VFp12bNjdxT1YyksMvFGK9xn", state.Transmissions.String())
 	assert.True(t, !stateCache.Timestamp().IsZero())
+	mockServer.Close()
+}
 
-	transmissionsCache := NewTransmissionsCache(
-		solana.MustPublicKeyFromBase58("11111111111111111111111111111112"),
-		"test-chain-id",
-		config.NewDefault(),
-		testSetupReader(t, mockServer.URL),
-		lggr,
-	)
-	require.NoError(t, transmissionsCache.Start(ctx))
-	require.NoError(t, transmissionsCache.Close())
+func TestCache_SlotMonotonic(t *testing.T) {
+	ctx := tests.Context(t)
 
-	require.NoError(t, transmissionsCache.Fetch(ctx))
-	answer, err := transmissionsCache.Read()
-	assert.NoError(t, err)
-	assert.Equal(t, expectedTime, answer.Timestamp)
-	assert.Equal(t, expectedAns, answer.Data.String())
-	mockServer.Close()
+	type result struct {
+		answer uint64
+		slot   uint64
+	}
+	results := []result{{answer: 1, slot: 10}, {answer: 2, slot: 12}, {answer: 3, slot: 11}}
+	var minSlots []*uint64
+
+	getter := func(ctx context.Context) (uint64, uint64, error) {
+		minSlots = append(minSlots, client.MinContextSlot(ctx))
+		res := results[0]
+		results = results[1:]
+		return res.answer, res.slot, nil
+	}
+	cache := client.NewCache("test", solana.PublicKey{}, "test-chain-id", config.NewDefault(), getter, logger.Test(t))
+
+	require.NoError(t, cache.Fetch(ctx))
+	require.NoError(t, cache.Fetch(ctx))
+
+	// results from older slots are rejected
+	require.Error(t, cache.Fetch(ctx))
+
+	answer, slot, err := cache.ReadWithSlot()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), answer)
+	assert.Equal(t, uint64(12), slot)
+
+	// requests after the first read at the slot of the cached result or later
+	require.Len(t, minSlots, 3)
+	assert.Nil(t, minSlots[0])
+	assert.Equal(t, uint64(10), *minSlots[1])
+	assert.Equal(t, uint64(12), *minSlots[2])
 }
 
 func TestNilPointerHandling(t *testing.T) {
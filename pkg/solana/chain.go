@@ -39,6 +39,10 @@ type Chain interface {
 	TxManager() TxManager
 	// Reader returns a new Reader from the available list of nodes (if there are multiple, it will randomly select one)
 	Reader() (client.Reader, error)
+	// AccountSubscriptions returns the shared account subscriptions or nil if no node has a websocket URL
+	AccountSubscriptions() *client.AccountSubscriptions
+	// TransmissionBatcher returns the shared transmission batcher or nil if batching is disabled
+	TransmissionBatcher() *TransmissionBatcher
 }
 
 // DefaultRequestTimeout is the default Solana client timeout.
@@ -86,6 +90,8 @@ type chain struct {
 	cfg            *config.TOMLConfig
 	txm            *txm.Txm
 	balanceMonitor services.Service
+	subscriptions  *client.AccountSubscriptions
+	batcher        *TransmissionBatcher
 	lggr           logger.Logger
 
 	// if multiNode is enabled, the clientCache will not be used
@@ -221,6 +227,33 @@ func (v *verifiedCachedClient) GetAccountInfoWithOpts(ctx context.Context, addr
 	return v.ReaderWriter.GetAccountInfoWithOpts(ctx, addr, opts)
 }
 
+func (v *verifiedCachedClient) GetMultipleAccountsWithOpts(ctx context.Context, accounts []solanago.PublicKey, opts *rpc.GetMultipleAccountsOpts) (*rpc.GetMultipleAccountsResult, error) {
+	verified, err := v.verifyChainID(ctx)
+	if !verified {
+		return nil, err
+	}
+
+	return v.ReaderWriter.GetMultipleAccountsWithOpts(ctx, accounts, opts)
+}
+
+func (v *verifiedCachedClient) GetSignaturesForAddressWithOpts(ctx context.Context, addr solanago.PublicKey, opts *rpc.GetSignaturesForAddressOpts) ([]*rpc.TransactionSignature, error) {
+	verified, err := v.verifyChainID(ctx)
+	if !verified {
+		return nil, err
+	}
+
+	return v.ReaderWriter.GetSignaturesForAddressWithOpts(ctx, addr, opts)
+}
+
+func (v *verifiedCachedClient) GetTransaction(ctx context.Context, txSig solanago.Signature, opts *rpc.GetTransactionOpts) (*rpc.GetTransactionResult, error) {
+	verified, err := v.verifyChainID(ctx)
+	if !verified {
+		return nil, err
+	}
+
+	return v.ReaderWriter.GetTransaction(ctx, txSig, opts)
+}
+
 func newChain(id string, cfg *config.TOMLConfig, ks loop.Keystore, lggr logger.Logger) (*chain, error) {
 	lggr = logger.With(lggr, "chainID", id, "chain", "solana")
 	var ch = chain{
@@ -294,11 +327,36 @@ func newChain(id string, cfg *config.TOMLConfig, ks loop.Keystore, lggr logger.L
 		return ch.getClient()
 	}
 	ch.txm = txm.NewTxm(ch.id, tc, cfg, ks, lggr)
+	if cfg.OCR2TransmitBatchWindow() > 0 {
+		ch.batcher = NewTransmissionBatcher(ch.id, cfg, tc, ch.txm, lggr)
+	}
 	bc := func() (monitor.BalanceClient, error) { return ch.getClient() }
 	ch.balanceMonitor = monitor.NewBalanceMonitor(ch.id, cfg, lggr, ks, bc)
+
+	for _, node := range cfg.ListNodes() {
+		if node.WSURL != nil && !node.SendOnly {
+			ch.subscriptions = client.NewAccountSubscriptions(node.WSURL.String(), chainAccountReader{&ch}, cfg, lggr)
+			break
+		}
+	}
+
 	return &ch, nil
 }
 
+// chainAccountReader reads accounts with a client selected for each request.
+type chainAccountReader struct {
+	chain *chain
+}
+
+func (r chainAccountReader) GetAccountInfoWithOpts(ctx context.Context, addr solanago.PublicKey, opts *rpc.GetAccountInfoOpts) (*rpc.GetAccountInfoResult, error) {
+	reader, err := r.chain.getClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return reader.GetAccountInfoWithOpts(ctx, addr, opts)
+}
+
 func (c *chain) LatestHead(ctx context.Context) (types.Head, error) {
 	sc, err := c.getClient()
 	if err != nil {
@@ -391,6 +449,14 @@ func (c *chain) Reader() (client.Reader, error) {
 	return c.getClient()
 }
 
+func (c *chain) AccountSubscriptions() *client.AccountSubscriptions {
+	return c.subscriptions
+}
+
+func (c *chain) TransmissionBatcher() *TransmissionBatcher {
+	return c.batcher
+}
+
 func (c *chain) ChainID() string {
 	return c.id
 }
@@ -486,6 +552,14 @@ func (c *chain) Start(ctx context.Context) error {
 			c.lggr.Debug("Starting multinode")
 			startAll = append(startAll, c.multiNode, c.txSender)
 		}
+		if c.subscriptions != nil {
+			c.lggr.Debug("Starting account subscriptions")
+			startAll = append(startAll, c.subscriptions)
+		}
+		if c.batcher != nil {
+			c.lggr.Debug("Starting transmission batcher")
+			startAll = append(startAll, c.batcher)
+		}
 		return ms.Start(ctx, startAll...)
 	})
 }
@@ -495,11 +569,21 @@ func (c *chain) Close() error {
 		c.lggr.Debug("Stopping")
 		c.lggr.Debug("Stopping txm")
 		c.lggr.Debug("Stopping balance monitor")
-		closeAll := []io.Closer{c.txm, c.balanceMonitor}
+		var closeAll []io.Closer
+		if c.batcher != nil {
+			// stop batching before the txm
+			c.lggr.Debug("Stopping transmission batcher")
+			closeAll = append(closeAll, c.batcher)
+		}
+		closeAll = append(closeAll, c.txm, c.balanceMonitor)
 		if c.cfg.MultiNode.Enabled() {
 			c.lggr.Debug("Stopping multinode")
 			closeAll = append(closeAll, c.multiNode, c.txSender)
 		}
+		if c.subscriptions != nil {
+			c.lggr.Debug("Stopping account subscriptions")
+			closeAll = append(closeAll, c.subscriptions)
+		}
 		return services.CloseAll(closeAll...)
 	})
 }
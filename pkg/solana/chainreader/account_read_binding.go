@@ -8,6 +8,7 @@ import (
 	"github.com/gagliardetto/solana-go/rpc"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
 )
 
 // BinaryDataReader provides an interface for reading bytes from a source. This is likely a wrapper
@@ -36,7 +37,7 @@ func newAccountReadBinding(acct string, codec types.RemoteCodec, reader BinaryDa
 
 var _ readBinding = &accountReadBinding{}
 
-func (b *accountReadBinding) PreLoad(ctx context.Context, address string, result *loadedResult) {
+func (b *accountReadBinding) PreLoad(ctx context.Context, address string, confidence primitives.ConfidenceLevel, result *loadedResult) {
 	if result == nil {
 		return
 	}
@@ -48,7 +49,14 @@ func (b *accountReadBinding) PreLoad(ctx context.Context, address string, result
 		return
 	}
 
-	bts, err := b.reader.ReadAll(ctx, account, b.opts)
+	opts, err := b.optsForConfidence(confidence)
+	if err != nil {
+		result.err <- err
+
+		return
+	}
+
+	bts, err := b.reader.ReadAll(ctx, account, opts)
 	if err != nil {
 		result.err <- fmt.Errorf("%w: failed to get binary data", err)
 
@@ -63,7 +71,7 @@ func (b *accountReadBinding) PreLoad(ctx context.Context, address string, result
 	}
 }
 
-func (b *accountReadBinding) GetLatestValue(ctx context.Context, address string, _ any, outVal any, result *loadedResult) error {
+func (b *accountReadBinding) GetLatestValue(ctx context.Context, address string, confidence primitives.ConfidenceLevel, _ any, outVal any, result *loadedResult) error {
 	var (
 		bts []byte
 		err error
@@ -90,7 +98,12 @@ func (b *accountReadBinding) GetLatestValue(ctx context.Context, address string,
 			return err
 		}
 
-		if bts, err = b.reader.ReadAll(ctx, account, b.opts); err != nil {
+		opts, err := b.optsForConfidence(confidence)
+		if err != nil {
+			return err
+		}
+
+		if bts, err = b.reader.ReadAll(ctx, account, opts); err != nil {
 			return fmt.Errorf("%w: failed to get binary data", err)
 		}
 	}
@@ -101,3 +114,22 @@ func (b *accountReadBinding) GetLatestValue(ctx context.Context, address string,
 func (b *accountReadBinding) CreateType(_ bool) (any, error) {
 	return b.codec.CreateType(b.idlAccount, false)
 }
+
+// optsForConfidence returns a copy of the configured rpc options with the commitment derived from the
+// requested confidence level. A commitment configured on the procedure is only used for unconfirmed
+// reads, which allows a procedure to opt into 'processed' data.
+func (b *accountReadBinding) optsForConfidence(confidence primitives.ConfidenceLevel) (*rpc.GetAccountInfoOpts, error) {
+	opts := &rpc.GetAccountInfoOpts{}
+	if b.opts != nil {
+		*opts = *b.opts
+	}
+
+	commitment, err := commitmentForConfidence(confidence, opts.Commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	opts.Commitment = commitment
+
+	return opts, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings"
 	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
 )
 
 func TestPreload(t *testing.T) {
@@ -44,11 +45,11 @@ func TestPreload(t *testing.T) {
 
 		pubKey := solana.NewWallet().PublicKey()
 
-		binding.PreLoad(ctx, pubKey.String(), loaded)
+		binding.PreLoad(ctx, pubKey.String(), primitives.Unconfirmed, loaded)
 
 		var result testStruct
 
-		err = binding.GetLatestValue(ctx, pubKey.String(), nil, &result, loaded)
+		err = binding.GetLatestValue(ctx, pubKey.String(), primitives.Unconfirmed, nil, &result, loaded)
 		elapsed := time.Since(start)
 
 		require.NoError(t, err)
@@ -82,10 +83,10 @@ func TestPreload(t *testing.T) {
 			err:   make(chan error, 1),
 		}
 		start := time.Now()
-		binding.PreLoad(ctx, pubKey.String(), loaded)
+		binding.PreLoad(ctx, pubKey.String(), primitives.Unconfirmed, loaded)
 
 		var result testStruct
-		err := binding.GetLatestValue(ctx, pubKey.String(), nil, &result, loaded)
+		err := binding.GetLatestValue(ctx, pubKey.String(), primitives.Unconfirmed, nil, &result, loaded)
 		elapsed := time.Since(start)
 
 		assert.ErrorIs(t, err, ctx.Err())
@@ -110,21 +111,81 @@ func TestPreload(t *testing.T) {
 			value: make(chan []byte, 1),
 			err:   make(chan error, 1),
 		}
-		binding.PreLoad(ctx, pubKey.String(), loaded)
+		binding.PreLoad(ctx, pubKey.String(), primitives.Unconfirmed, loaded)
 
 		var result testStruct
-		err := binding.GetLatestValue(ctx, pubKey.String(), nil, &result, loaded)
+		err := binding.GetLatestValue(ctx, pubKey.String(), primitives.Unconfirmed, nil, &result, loaded)
 
 		assert.ErrorIs(t, err, expectedErr)
 	})
 }
 
+func TestAccountReadBinding_Confidence(t *testing.T) {
+	t.Parallel()
+
+	testCodec := makeTestCodec(t)
+	expected := testStruct{A: true, B: 42}
+	bts, err := testCodec.Encode(context.Background(), expected, testCodecKey)
+
+	require.NoError(t, err)
+
+	withCommitment := func(commitment rpc.CommitmentType) any {
+		return mock.MatchedBy(func(opts *rpc.GetAccountInfoOpts) bool {
+			return opts != nil && opts.Commitment == commitment
+		})
+	}
+
+	processed := rpc.CommitmentProcessed
+
+	for _, test := range []struct {
+		name       string
+		opts       *rpc.GetAccountInfoOpts
+		confidence primitives.ConfidenceLevel
+		expected   rpc.CommitmentType
+	}{
+		{name: "unconfirmed defaults to confirmed", confidence: primitives.Unconfirmed, expected: rpc.CommitmentConfirmed},
+		{name: "unconfirmed uses configured commitment", opts: &rpc.GetAccountInfoOpts{Commitment: processed}, confidence: primitives.Unconfirmed, expected: processed},
+		{name: "finalized overrides configured commitment", opts: &rpc.GetAccountInfoOpts{Commitment: processed}, confidence: primitives.Finalized, expected: rpc.CommitmentFinalized},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			reader := new(mockReader)
+			binding := newAccountReadBinding(testCodecKey, testCodec, reader, test.opts)
+
+			reader.On("ReadAll", mock.Anything, mock.Anything, withCommitment(test.expected)).Return(bts, nil)
+
+			var result testStruct
+
+			require.NoError(t, binding.GetLatestValue(context.Background(), solana.NewWallet().PublicKey().String(), test.confidence, nil, &result, nil))
+			assert.Equal(t, expected, result)
+			reader.AssertExpectations(t)
+
+			if test.opts != nil {
+				// configured options are not modified by a read
+				assert.Equal(t, processed, test.opts.Commitment)
+			}
+		})
+	}
+
+	t.Run("unknown confidence level returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		binding := newAccountReadBinding(testCodecKey, testCodec, new(mockReader), nil)
+
+		var result testStruct
+
+		err := binding.GetLatestValue(context.Background(), solana.NewWallet().PublicKey().String(), "unknown", nil, &result, nil)
+		assert.ErrorIs(t, err, types.ErrInvalidType)
+	})
+}
+
 type mockReader struct {
 	mock.Mock
 }
 
 func (_m *mockReader) ReadAll(ctx context.Context, pk solana.PublicKey, opts *rpc.GetAccountInfoOpts) ([]byte, error) {
-	ret := _m.Called(ctx, pk)
+	ret := _m.Called(ctx, pk, opts)
 
 	var r0 []byte
 	if val, ok := ret.Get(0).([]byte); ok {
@@ -0,0 +1,137 @@
+package chainreader
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/go-viper/mapstructure/v2"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+// BalanceReader reads native and SPL token balances and is required for balance procedures. This
+// is likely a wrapper for a solana client.
+type BalanceReader interface {
+	GetBalance(context.Context, solana.PublicKey, rpc.CommitmentType) (*rpc.GetBalanceResult, error)
+	GetTokenAccountBalance(context.Context, solana.PublicKey, rpc.CommitmentType) (*rpc.GetTokenAccountBalanceResult, error)
+	GetTokenSupply(context.Context, solana.PublicKey, rpc.CommitmentType) (*rpc.GetTokenSupplyResult, error)
+}
+
+// NativeBalance is the result of a config.BalanceTypeLamports read.
+type NativeBalance struct {
+	Lamports uint64
+}
+
+// TokenBalance is the result of a config.BalanceTypeTokenAccount read. The amount is in the smallest
+// unit of the token.
+type TokenBalance struct {
+	Amount   uint64
+	Decimals uint8
+}
+
+// TokenSupply is the result of a config.BalanceTypeMintSupply read. The supply is in the smallest
+// unit of the token.
+type TokenSupply struct {
+	Supply   uint64
+	Decimals uint8
+}
+
+// balanceReadBinding reads the balance of the bound address with the RPC method of the balance type.
+type balanceReadBinding struct {
+	balance config.BalanceType
+	reader  BalanceReader
+}
+
+func newBalanceReadBinding(balance config.BalanceType, reader BalanceReader) (*balanceReadBinding, error) {
+	switch balance {
+	case config.BalanceTypeLamports, config.BalanceTypeTokenAccount, config.BalanceTypeMintSupply:
+		return &balanceReadBinding{balance: balance, reader: reader}, nil
+	default:
+		return nil, fmt.Errorf("%w: unrecognized balance type: %s", types.ErrInvalidConfig, balance)
+	}
+}
+
+var _ readBinding = &balanceReadBinding{}
+
+// PreLoad is a no-op because balances are read with a single request.
+func (b *balanceReadBinding) PreLoad(_ context.Context, _ string, _ primitives.ConfidenceLevel, _ *loadedResult) {
+}
+
+func (b *balanceReadBinding) GetLatestValue(ctx context.Context, address string, confidence primitives.ConfidenceLevel, _, outVal any, _ *loadedResult) error {
+	account, err := solana.PublicKeyFromBase58(address)
+	if err != nil {
+		return err
+	}
+
+	commitment, err := commitmentForConfidence(confidence, "")
+	if err != nil {
+		return err
+	}
+
+	var result any
+
+	switch b.balance {
+	case config.BalanceTypeLamports:
+		res, err := b.reader.GetBalance(ctx, account, commitment)
+		if err != nil {
+			return fmt.Errorf("%w: failed to get balance", err)
+		}
+
+		if res == nil {
+			return fmt.Errorf("%w: balance for %s", types.ErrNotFound, account)
+		}
+
+		result = NativeBalance{Lamports: res.Value}
+	case config.BalanceTypeTokenAccount:
+		res, err := b.reader.GetTokenAccountBalance(ctx, account, commitment)
+		if err != nil {
+			return fmt.Errorf("%w: failed to get token account balance", err)
+		}
+
+		if res == nil || res.Value == nil {
+			return fmt.Errorf("%w: token account balance for %s", types.ErrNotFound, account)
+		}
+
+		amount, err := strconv.ParseUint(res.Value.Amount, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: invalid token amount: %s", types.ErrInvalidEncoding, err)
+		}
+
+		result = TokenBalance{Amount: amount, Decimals: res.Value.Decimals}
+	case config.BalanceTypeMintSupply:
+		res, err := b.reader.GetTokenSupply(ctx, account, commitment)
+		if err != nil {
+			return fmt.Errorf("%w: failed to get token supply", err)
+		}
+
+		if res == nil || res.Value == nil {
+			return fmt.Errorf("%w: token supply for %s", types.ErrNotFound, account)
+		}
+
+		supply, err := strconv.ParseUint(res.Value.Amount, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w: invalid token supply: %s", types.ErrInvalidEncoding, err)
+		}
+
+		result = TokenSupply{Supply: supply, Decimals: res.Value.Decimals}
+	}
+
+	return mapstructure.Decode(result, outVal)
+}
+
+func (b *balanceReadBinding) CreateType(_ bool) (any, error) {
+	switch b.balance {
+	case config.BalanceTypeLamports:
+		return &NativeBalance{}, nil
+	case config.BalanceTypeTokenAccount:
+		return &TokenBalance{}, nil
+	default:
+		return &TokenSupply{}, nil
+	}
+}
@@ -0,0 +1,135 @@
+package chainreader_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	ag_solana "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/chainreader"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+func TestSolanaChainReaderService_BalanceProcedures(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+
+	account := ag_solana.NewWallet().PublicKey()
+	vault := ag_solana.NewWallet().PublicKey()
+	mint := ag_solana.NewWallet().PublicKey()
+
+	balanceMethod := func(balance config.BalanceType) config.ChainDataReader {
+		return config.ChainDataReader{Procedures: []config.ChainReaderProcedure{{Balance: balance}}}
+	}
+
+	conf := config.ChainReader{Namespaces: map[string]config.ChainReaderMethods{
+		Namespace: {Methods: map[string]config.ChainDataReader{
+			"Lamports":     balanceMethod(config.BalanceTypeLamports),
+			"LinkBalance":  balanceMethod(config.BalanceTypeTokenAccount),
+			"LinkSupply":   balanceMethod(config.BalanceTypeMintSupply),
+			"MissingVault": balanceMethod(config.BalanceTypeTokenAccount),
+		}},
+	}}
+
+	client := &mockedBalanceReader{
+		mockedRPCClient: new(mockedRPCClient),
+		lamports:        map[ag_solana.PublicKey]uint64{account: 5_000},
+		tokens: map[ag_solana.PublicKey]*rpc.UiTokenAmount{
+			vault: {Amount: "18446744073709551615", Decimals: 18},
+			mint:  {Amount: "1000000000000000000", Decimals: 18},
+		},
+	}
+
+	svc, err := chainreader.NewChainReaderService(logger.Test(t), client, conf)
+	require.NoError(t, err)
+	require.NoError(t, svc.Start(ctx))
+
+	t.Cleanup(func() {
+		require.NoError(t, svc.Close())
+	})
+
+	addresses, err := json.Marshal(map[string][]string{
+		"Lamports":     {account.String()},
+		"LinkBalance":  {vault.String()},
+		"LinkSupply":   {mint.String()},
+		"MissingVault": {ag_solana.NewWallet().PublicKey().String()},
+	})
+	require.NoError(t, err)
+
+	binding := types.BoundContract{Name: Namespace, Address: base64.StdEncoding.EncodeToString(addresses)}
+	require.NoError(t, svc.Bind(ctx, []types.BoundContract{binding}))
+
+	var lamports chainreader.NativeBalance
+	require.NoError(t, svc.GetLatestValue(ctx, binding.ReadIdentifier("Lamports"), primitives.Unconfirmed, nil, &lamports))
+	assert.Equal(t, uint64(5_000), lamports.Lamports)
+
+	var balance chainreader.TokenBalance
+	require.NoError(t, svc.GetLatestValue(ctx, binding.ReadIdentifier("LinkBalance"), primitives.Finalized, nil, &balance))
+	assert.Equal(t, chainreader.TokenBalance{Amount: 18446744073709551615, Decimals: 18}, balance)
+
+	var supply map[string]any
+	require.NoError(t, svc.GetLatestValue(ctx, binding.ReadIdentifier("LinkSupply"), primitives.Unconfirmed, nil, &supply))
+	assert.Equal(t, map[string]any{"Supply": uint64(1_000_000_000_000_000_000), "Decimals": uint8(18)}, supply)
+
+	var value values.Value
+	require.NoError(t, svc.GetLatestValue(ctx, binding.ReadIdentifier("LinkBalance"), primitives.Unconfirmed, nil, &value))
+
+	var fromValue chainreader.TokenBalance
+	require.NoError(t, value.UnwrapTo(&fromValue))
+	assert.Equal(t, balance, fromValue)
+
+	require.ErrorIs(t, svc.GetLatestValue(ctx, binding.ReadIdentifier("MissingVault"), primitives.Unconfirmed, nil, &balance), types.ErrNotFound)
+
+	t.Run("invalid config", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := chainreader.NewChainReaderService(logger.Test(t), client, config.ChainReader{Namespaces: map[string]config.ChainReaderMethods{
+			Namespace: {Methods: map[string]config.ChainDataReader{"Unknown": balanceMethod("unknown")}},
+		}})
+		require.ErrorIs(t, err, types.ErrInvalidConfig)
+
+		_, err = chainreader.NewChainReaderService(logger.Test(t), new(mockedRPCClient), conf)
+		require.ErrorIs(t, err, types.ErrInvalidConfig)
+
+		// a procedure cannot read both a balance and rounds of a transmissions account
+		combined := balanceMethod(config.BalanceTypeLamports)
+		combined.Procedures[0].Transmissions = config.TransmissionsTypeRoundData
+
+		_, err = chainreader.NewChainReaderService(logger.Test(t), client, config.ChainReader{Namespaces: map[string]config.ChainReaderMethods{
+			Namespace: {Methods: map[string]config.ChainDataReader{"Combined": combined}},
+		}})
+		require.ErrorIs(t, err, types.ErrInvalidConfig)
+		require.ErrorContains(t, err, "only one of balance, transmissions can be set")
+	})
+}
+
+type mockedBalanceReader struct {
+	*mockedRPCClient
+
+	lamports map[ag_solana.PublicKey]uint64
+	tokens   map[ag_solana.PublicKey]*rpc.UiTokenAmount
+}
+
+func (m *mockedBalanceReader) GetBalance(_ context.Context, account ag_solana.PublicKey, _ rpc.CommitmentType) (*rpc.GetBalanceResult, error) {
+	return &rpc.GetBalanceResult{Value: m.lamports[account]}, nil
+}
+
+func (m *mockedBalanceReader) GetTokenAccountBalance(_ context.Context, account ag_solana.PublicKey, _ rpc.CommitmentType) (*rpc.GetTokenAccountBalanceResult, error) {
+	return &rpc.GetTokenAccountBalanceResult{Value: m.tokens[account]}, nil
+}
+
+func (m *mockedBalanceReader) GetTokenSupply(_ context.Context, mint ag_solana.PublicKey, _ rpc.CommitmentType) (*rpc.GetTokenSupplyResult, error) {
+	return &rpc.GetTokenSupplyResult{Value: m.tokens[mint]}, nil
+}
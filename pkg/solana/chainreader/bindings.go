@@ -8,11 +8,12 @@ import (
 	"github.com/gagliardetto/solana-go"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
 )
 
 type readBinding interface {
-	PreLoad(context.Context, string, *loadedResult)
-	GetLatestValue(ctx context.Context, address string, params, returnVal any, preload *loadedResult) error
+	PreLoad(context.Context, string, primitives.ConfidenceLevel, *loadedResult)
+	GetLatestValue(ctx context.Context, address string, confidence primitives.ConfidenceLevel, params, returnVal any, preload *loadedResult) error
 	CreateType(bool) (any, error)
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
 )
 
 func TestBindings_CreateType(t *testing.T) {
@@ -105,9 +106,9 @@ type mockBinding struct {
 	mock.Mock
 }
 
-func (_m *mockBinding) PreLoad(context.Context, string, *loadedResult) {}
+func (_m *mockBinding) PreLoad(context.Context, string, primitives.ConfidenceLevel, *loadedResult) {}
 
-func (_m *mockBinding) GetLatestValue(ctx context.Context, address string, params, returnVal any, _ *loadedResult) error {
+func (_m *mockBinding) GetLatestValue(ctx context.Context, address string, _ primitives.ConfidenceLevel, params, returnVal any, _ *loadedResult) error {
 	return nil
 }
 
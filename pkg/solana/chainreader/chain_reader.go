@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 
 	ag_solana "github.com/gagliardetto/solana-go"
@@ -20,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
 
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
 )
@@ -36,9 +38,15 @@ type SolanaChainReaderService struct {
 	// internal values
 	bindings namespaceBindings
 	lookup   *lookup
+	// programs with methods that are bound when their IDL is fetched, set once by init
+	onChainIDLPrograms map[ag_solana.PublicKey]*onChainIDLProgram
+	pendingIDLLock     sync.Mutex
+	// guards the bindings added for on-chain IDLs
+	idlLock sync.RWMutex
 
 	// service state management
-	wg sync.WaitGroup
+	wg     sync.WaitGroup
+	stopCh services.StopChan
 	services.StateMachine
 }
 
@@ -54,6 +62,9 @@ func NewChainReaderService(lggr logger.Logger, dataReader BinaryDataReader, cfg
 		client:   dataReader,
 		bindings: namespaceBindings{},
 		lookup:   newLookup(),
+		stopCh:   make(chan struct{}),
+
+		onChainIDLPrograms: make(map[ag_solana.PublicKey]*onChainIDLProgram),
 	}
 
 	if err := svc.init(cfg.Namespaces); err != nil {
@@ -69,10 +80,24 @@ func (s *SolanaChainReaderService) Name() string {
 }
 
 // Start implements the services.ServiceCtx interface and starts necessary background services.
-// An error is returned if starting any internal services fails. Subsequent calls to Start return
-// and error.
-func (s *SolanaChainReaderService) Start(_ context.Context) error {
+// On-chain IDLs are fetched and their read bindings added. A program that fails to load does not fail
+// the start; fetch failures are retried in the background and by reads of the pending methods, while reads
+// of methods with an invalid IDL config fail. An error is returned if starting any internal services fails.
+// Subsequent calls to Start return and error.
+func (s *SolanaChainReaderService) Start(ctx context.Context) error {
 	return s.StartOnce(ServiceName, func() error {
+		err := s.loadOnChainIDLs(ctx)
+		if err == nil {
+			return nil
+		}
+
+		s.lggr.Errorw("Failed to load on-chain IDLs", "err", err)
+
+		if s.retryableOnChainIDLs() {
+			s.wg.Add(1)
+			go s.retryOnChainIDLs()
+		}
+
 		return nil
 	})
 }
@@ -81,6 +106,7 @@ func (s *SolanaChainReaderService) Start(_ context.Context) error {
 // up used resources. Subsequent calls to Close return an error.
 func (s *SolanaChainReaderService) Close() error {
 	return s.StopOnce(ServiceName, func() error {
+		close(s.stopCh)
 		s.wg.Wait()
 
 		return nil
@@ -100,12 +126,17 @@ func (s *SolanaChainReaderService) HealthReport() map[string]error {
 }
 
 // GetLatestValue implements the types.ContractReader interface and requests and parses on-chain
-// data named by the provided contract, method, and params.
-func (s *SolanaChainReaderService) GetLatestValue(ctx context.Context, readIdentifier string, _ primitives.ConfidenceLevel, params any, returnVal any) error {
+// data named by the provided contract, method, and params. The confidence level is mapped to a
+// Solana commitment for all account reads.
+func (s *SolanaChainReaderService) GetLatestValue(ctx context.Context, readIdentifier string, confidence primitives.ConfidenceLevel, params any, returnVal any) error {
 	if err := s.Ready(); err != nil {
 		return err
 	}
 
+	if _, err := commitmentForConfidence(confidence, ""); err != nil {
+		return err
+	}
+
 	s.wg.Add(1)
 	defer s.wg.Done()
 
@@ -124,7 +155,14 @@ func (s *SolanaChainReaderService) GetLatestValue(ctx context.Context, readIdent
 		return fmt.Errorf("%w: no addresses for readName %s", types.ErrInvalidConfig, vals.readName)
 	}
 
+	if err = s.ensureOnChainIDL(ctx, vals.contract, vals.readName); err != nil {
+		return err
+	}
+
+	s.idlLock.RLock()
 	bindings, err := s.bindings.GetReadBindings(vals.contract, vals.readName)
+	s.idlLock.RUnlock()
+
 	if err != nil {
 		return err
 	}
@@ -136,16 +174,19 @@ func (s *SolanaChainReaderService) GetLatestValue(ctx context.Context, readIdent
 	// if the returnVal is not a *values.Value, run normally without using the ptrToValue
 	ptrToValue, isValue := returnVal.(*values.Value)
 	if !isValue {
-		return s.runAllBindings(ctx, bindings, addresses, params, returnVal)
+		return s.runAllBindings(ctx, bindings, addresses, confidence, params, returnVal)
 	}
 
 	// if the returnVal is a *values.Value, create the type from the contract, run normally, and wrap the value
+	s.idlLock.RLock()
 	contractType, err := s.bindings.CreateType(vals.contract, vals.readName, false)
+	s.idlLock.RUnlock()
+
 	if err != nil {
 		return err
 	}
 
-	if err = s.runAllBindings(ctx, bindings, addresses, params, contractType); err != nil {
+	if err = s.runAllBindings(ctx, bindings, addresses, confidence, params, contractType); err != nil {
 		return err
 	}
 
@@ -163,6 +204,7 @@ func (s *SolanaChainReaderService) runAllBindings(
 	ctx context.Context,
 	bindings []readBinding,
 	addresses []string,
+	confidence primitives.ConfidenceLevel,
 	params, returnVal any,
 ) error {
 	localCtx, localCancel := context.WithCancel(ctx)
@@ -202,7 +244,7 @@ func (s *SolanaChainReaderService) runAllBindings(
 			go func(ctx context.Context, rb readBinding, res *loadedResult, address string) {
 				defer wg.Done()
 
-				rb.PreLoad(ctx, address, res)
+				rb.PreLoad(ctx, address, confidence, res)
 			}(localCtx, binding, results[idx], addresses[idx])
 		}
 	}
@@ -212,7 +254,7 @@ func (s *SolanaChainReaderService) runAllBindings(
 	// in the case of no preloading, GetLatestValue will load and decode in
 	// sequence.
 	for idx, binding := range bindings {
-		if err := binding.GetLatestValue(ctx, addresses[idx], params, returnVal, results[idx]); err != nil {
+		if err := binding.GetLatestValue(ctx, addresses[idx], confidence, params, returnVal, results[idx]); err != nil {
 			localCancel()
 
 			wg.Wait()
@@ -228,21 +270,51 @@ func (s *SolanaChainReaderService) runAllBindings(
 	return nil
 }
 
-// BatchGetLatestValues implements the types.ContractReader interface.
-func (s *SolanaChainReaderService) BatchGetLatestValues(_ context.Context, _ types.BatchGetLatestValuesRequest) (types.BatchGetLatestValuesResult, error) {
-	return nil, errors.New("unimplemented")
+// BatchGetLatestValues implements the types.ContractReader interface. A batch read does not carry a
+// confidence level, so all reads are done with primitives.Unconfirmed. Errors for individual reads
+// are returned in the result for that read.
+func (s *SolanaChainReaderService) BatchGetLatestValues(ctx context.Context, request types.BatchGetLatestValuesRequest) (types.BatchGetLatestValuesResult, error) {
+	if err := s.Ready(); err != nil {
+		return nil, err
+	}
+
+	result := make(types.BatchGetLatestValuesResult, len(request))
+
+	for contract, batch := range request {
+		results := make(types.ContractBatchResults, len(batch))
+
+		for idx, read := range batch {
+			err := s.GetLatestValue(ctx, contract.ReadIdentifier(read.ReadName), primitives.Unconfirmed, read.Params, read.ReturnVal)
+
+			results[idx] = types.BatchReadResult{ReadName: read.ReadName}
+			results[idx].SetResult(read.ReturnVal, err)
+		}
+
+		result[contract] = results
+	}
+
+	return result, nil
 }
 
-// QueryKey implements the types.ContractReader interface.
+// QueryKey implements the types.ContractReader interface. Events are not indexed yet, so there are no sequences
+// with cursors to query.
 func (s *SolanaChainReaderService) QueryKey(_ context.Context, _ types.BoundContract, _ query.KeyFilter, _ query.LimitAndSort, _ any) ([]types.Sequence, error) {
 	return nil, errors.New("unimplemented")
 }
 
 // Bind implements the types.ContractReader interface and allows new contract bindings to be added
-// to the service.
-func (s *SolanaChainReaderService) Bind(_ context.Context, bindings []types.BoundContract) error {
+// to the service. Pending on-chain IDLs of the bound contracts are loaded first.
+func (s *SolanaChainReaderService) Bind(ctx context.Context, bindings []types.BoundContract) error {
 	for _, binding := range bindings {
-		if err := s.bindings.Bind(binding); err != nil {
+		if err := s.ensureOnChainIDL(ctx, binding.Name, ""); err != nil {
+			return err
+		}
+
+		s.idlLock.RLock()
+		err := s.bindings.Bind(binding)
+		s.idlLock.RUnlock()
+
+		if err != nil {
 			return err
 		}
 
@@ -270,50 +342,182 @@ func (s *SolanaChainReaderService) CreateContractType(readIdentifier string, for
 		return nil, fmt.Errorf("%w: no contract for read identifier", types.ErrInvalidConfig)
 	}
 
+	s.idlLock.RLock()
+	defer s.idlLock.RUnlock()
+
 	return s.bindings.CreateType(values.contract, values.readName, forEncoding)
 }
 
 func (s *SolanaChainReaderService) init(namespaces map[string]config.ChainReaderMethods) error {
 	for namespace, methods := range namespaces {
 		for methodName, method := range methods.Methods {
+			s.lookup.addReadNameForContract(namespace, methodName)
+
+			if method.OnChainIDL != nil {
+				if method.AnchorIDL != "" {
+					return fmt.Errorf("%w: only one of anchorIDL and onChainIDL can be set for %s.%s", types.ErrInvalidConfig, namespace, methodName)
+				}
+
+				if err := s.addOnChainIDLMethod(namespace, methodName, method); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			// methods with only balance or transmissions procedures do not need an IDL
 			var idl codec.IDL
-			if err := json.Unmarshal([]byte(method.AnchorIDL), &idl); err != nil {
+			if method.AnchorIDL != "" {
+				if err := json.Unmarshal([]byte(method.AnchorIDL), &idl); err != nil {
+					return err
+				}
+			}
+
+			if err := s.addMethod(namespace, methodName, idl, method); err != nil {
 				return err
 			}
+		}
+	}
 
-			idlCodec, err := codec.NewIDLAccountCodec(idl, config.BuilderForEncoding(method.Encoding))
+	return nil
+}
+
+func (s *SolanaChainReaderService) addMethod(namespace, methodName string, idl codec.IDL, method config.ChainDataReader) error {
+	idlCodec, err := newIDLAccountCodec(idl, method)
+	if err != nil {
+		return err
+	}
+
+	for _, procedure := range method.Procedures {
+		if kinds := procedureKinds(procedure); len(kinds) > 1 {
+			return fmt.Errorf("%w: only one of %s can be set for a procedure of %s.%s", types.ErrInvalidConfig, strings.Join(kinds, ", "), namespace, methodName)
+		}
+
+		if procedure.Balance != "" {
+			reader, ok := s.client.(BalanceReader)
+			if !ok {
+				return fmt.Errorf("%w: reader cannot read balances", types.ErrInvalidConfig)
+			}
+
+			binding, err := newBalanceReadBinding(procedure.Balance, reader)
 			if err != nil {
 				return err
 			}
 
-			s.lookup.addReadNameForContract(namespace, methodName)
+			s.bindings.AddReadBinding(namespace, methodName, binding)
 
-			for _, procedure := range method.Procedures {
-				injectAddressModifier(procedure.OutputModifications)
+			continue
+		}
 
-				mod, err := procedure.OutputModifications.ToModifier(codec.DecoderHooks...)
-				if err != nil {
-					return err
-				}
+		if procedure.Transmissions != "" {
+			reader, ok := s.client.(RoundDataReader)
+			if !ok {
+				return fmt.Errorf("%w: reader cannot read rounds of transmissions accounts", types.ErrInvalidConfig)
+			}
 
-				codecWithModifiers, err := codec.NewNamedModifierCodec(idlCodec, procedure.IDLAccount, mod)
-				if err != nil {
-					return err
-				}
+			binding, err := newTransmissionsReadBinding(procedure.Transmissions, reader)
+			if err != nil {
+				return err
+			}
+
+			s.bindings.AddReadBinding(namespace, methodName, binding)
 
-				s.bindings.AddReadBinding(namespace, methodName, newAccountReadBinding(
-					procedure.IDLAccount,
-					codecWithModifiers,
-					s.client,
-					createRPCOpts(procedure.RPCOpts),
-				))
+			continue
+		}
+
+		if procedure.IDLInstruction != "" {
+			simulator, ok := s.client.(TransactionSimulator)
+			if !ok {
+				return fmt.Errorf("%w: reader cannot simulate instruction %s", types.ErrInvalidConfig, procedure.IDLInstruction)
 			}
+
+			binding, err := newInstructionReadBinding(idl, method, procedure, simulator)
+			if err != nil {
+				return err
+			}
+
+			s.bindings.AddReadBinding(namespace, methodName, binding)
+
+			continue
+		}
+
+		injectAddressModifier(procedure.OutputModifications)
+
+		mod, err := procedure.OutputModifications.ToModifier(codec.DecoderHooks...)
+		if err != nil {
+			return err
+		}
+
+		codecWithModifiers, err := codec.NewNamedModifierCodec(idlCodec, procedure.IDLAccount, mod)
+		if err != nil {
+			return err
 		}
+
+		if procedure.ProgramAccounts != nil {
+			reader, ok := s.client.(ProgramAccountsReader)
+			if !ok {
+				return fmt.Errorf("%w: reader cannot list program accounts for %s", types.ErrInvalidConfig, procedure.IDLAccount)
+			}
+
+			binding, err := newProgramAccountsReadBinding(idl, method, procedure, codecWithModifiers, reader)
+			if err != nil {
+				return err
+			}
+
+			s.bindings.AddReadBinding(namespace, methodName, binding)
+
+			continue
+		}
+
+		s.bindings.AddReadBinding(namespace, methodName, newAccountReadBinding(
+			procedure.IDLAccount,
+			codecWithModifiers,
+			s.client,
+			createRPCOpts(procedure.RPCOpts),
+		))
 	}
 
 	return nil
 }
 
+// procedureKinds returns the names of the read kinds a procedure sets instead of reading an IDL account.
+func procedureKinds(procedure config.ChainReaderProcedure) []string {
+	var kinds []string
+
+	if procedure.Balance != "" {
+		kinds = append(kinds, "balance")
+	}
+
+	if procedure.Transmissions != "" {
+		kinds = append(kinds, "transmissions")
+	}
+
+	if procedure.IDLInstruction != "" {
+		kinds = append(kinds, "idlInstruction")
+	}
+
+	if procedure.ProgramAccounts != nil {
+		kinds = append(kinds, "programAccounts")
+	}
+
+	return kinds
+}
+
+func newIDLAccountCodec(idl codec.IDL, method config.ChainDataReader) (types.RemoteCodec, error) {
+	builder := config.BuilderForEncoding(method.Encoding)
+
+	switch {
+	case method.Encoding == config.EncodingTypeBytemuck && method.DisableDiscriminator:
+		return nil, fmt.Errorf("%w: zero-copy accounts always have a discriminator", types.ErrInvalidConfig)
+	case method.Encoding == config.EncodingTypeBytemuck:
+		return codec.NewIDLZeroCopyAccountCodec(idl, builder)
+	case method.DisableDiscriminator:
+		return codec.NewIDLAccountCodecWithoutDiscriminator(idl, builder)
+	default:
+		return codec.NewIDLAccountCodec(idl, builder)
+	}
+}
+
 // injectAddressModifier injects AddressModifier into OutputModifications.
 // This is necessary because AddressModifier cannot be serialized and must be applied at runtime.
 func injectAddressModifier(outputModifications codeccommon.ModifiersConfig) {
@@ -325,6 +529,23 @@ func injectAddressModifier(outputModifications codeccommon.ModifiersConfig) {
 	}
 }
 
+// commitmentForConfidence maps a chain agnostic confidence level to a Solana commitment. Unconfirmed
+// reads use the provided commitment when defined and default to 'confirmed' otherwise.
+func commitmentForConfidence(confidence primitives.ConfidenceLevel, unconfirmed rpc.CommitmentType) (rpc.CommitmentType, error) {
+	switch confidence {
+	case primitives.Finalized:
+		return rpc.CommitmentFinalized, nil
+	case primitives.Unconfirmed:
+		if unconfirmed != "" {
+			return unconfirmed, nil
+		}
+
+		return rpc.CommitmentConfirmed, nil
+	default:
+		return "", fmt.Errorf("%w: unsupported confidence level: %s", types.ErrInvalidType, confidence)
+	}
+}
+
 func createRPCOpts(opts *config.RPCOpts) *rpc.GetAccountInfoOpts {
 	if opts == nil {
 		return nil
@@ -346,14 +567,55 @@ func createRPCOpts(opts *config.RPCOpts) *rpc.GetAccountInfoOpts {
 }
 
 type accountDataReader struct {
-	client *rpc.Client
+	client        *rpc.Client
+	subscriptions *client.AccountSubscriptions
 }
 
 func NewAccountDataReader(client *rpc.Client) *accountDataReader {
 	return &accountDataReader{client: client}
 }
 
+// NewSubscribedAccountDataReader reads accounts of read bindings from the shared account subscriptions of the
+// chain, which fall back to the RPC for data slices and accounts without an update yet. Subscriptions may be
+// nil if the chain has no websocket endpoint, in which case all accounts are read from the RPC.
+func NewSubscribedAccountDataReader(rpcClient *rpc.Client, subscriptions *client.AccountSubscriptions) *accountDataReader {
+	return &accountDataReader{client: rpcClient, subscriptions: subscriptions}
+}
+
+func (r *accountDataReader) SimulateTx(ctx context.Context, tx *ag_solana.Transaction, opts *rpc.SimulateTransactionOpts) (*rpc.SimulateTransactionResult, error) {
+	result, err := r.client.SimulateTransactionWithOpts(ctx, tx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil || result.Value == nil {
+		return nil, errors.New("nil pointer in SimulateTransactionWithOpts")
+	}
+
+	return result.Value, nil
+}
+
+func (r *accountDataReader) GetProgramAccountsWithOpts(ctx context.Context, programID ag_solana.PublicKey, opts *rpc.GetProgramAccountsOpts) (rpc.GetProgramAccountsResult, error) {
+	return r.client.GetProgramAccountsWithOpts(ctx, programID, opts)
+}
+
+func (r *accountDataReader) GetBalance(ctx context.Context, account ag_solana.PublicKey, commitment rpc.CommitmentType) (*rpc.GetBalanceResult, error) {
+	return r.client.GetBalance(ctx, account, commitment)
+}
+
+func (r *accountDataReader) GetTokenAccountBalance(ctx context.Context, account ag_solana.PublicKey, commitment rpc.CommitmentType) (*rpc.GetTokenAccountBalanceResult, error) {
+	return r.client.GetTokenAccountBalance(ctx, account, commitment)
+}
+
+func (r *accountDataReader) GetTokenSupply(ctx context.Context, mint ag_solana.PublicKey, commitment rpc.CommitmentType) (*rpc.GetTokenSupplyResult, error) {
+	return r.client.GetTokenSupply(ctx, mint, commitment)
+}
+
 func (r *accountDataReader) ReadAll(ctx context.Context, pk ag_solana.PublicKey, opts *rpc.GetAccountInfoOpts) ([]byte, error) {
+	if r.subscriptions != nil {
+		return r.subscriptions.ReadAll(ctx, pk, opts)
+	}
+
 	result, err := r.client.GetAccountInfoWithOpts(ctx, pk, opts)
 	if err != nil {
 		return nil, err
@@ -33,6 +33,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/chainreader"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec/testutils"
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
@@ -285,6 +286,46 @@ func TestSolanaChainReaderService_GetLatestValue(t *testing.T) {
 	})
 }
 
+func TestSubscribedAccountDataReader(t *testing.T) {
+	t.Parallel()
+
+	account := ag_solana.NewWallet().PublicKey()
+	accounts := &mockedAccountReader{data: map[ag_solana.PublicKey][]byte{account: {1, 2, 3}}}
+
+	// the subscriptions are not started, so reads fall back to the reader of the subscriptions instead of the
+	// unreachable RPC client
+	subscriptions := client.NewAccountSubscriptions("ws://127.0.0.1:0", accounts, config.NewDefault(), logger.Test(t))
+	reader := chainreader.NewSubscribedAccountDataReader(rpc.New("http://127.0.0.1:0"), subscriptions)
+
+	data, err := reader.ReadAll(tests.Context(t), account, &rpc.GetAccountInfoOpts{Encoding: solana.EncodingBase64})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, data)
+}
+
+type mockedAccountReader struct {
+	data map[ag_solana.PublicKey][]byte
+}
+
+func (m *mockedAccountReader) GetAccountInfoWithOpts(_ context.Context, account ag_solana.PublicKey, _ *rpc.GetAccountInfoOpts) (*rpc.GetAccountInfoResult, error) {
+	data, ok := m.data[account]
+	if !ok {
+		return nil, rpc.ErrNotFound
+	}
+
+	return &rpc.GetAccountInfoResult{Value: &rpc.Account{Data: rpc.DataBytesOrJSONFromBytes(data)}}, nil
+}
+
+func TestSolanaChainReaderService_QueryKey(t *testing.T) {
+	t.Parallel()
+
+	svc, err := chainreader.NewChainReaderService(logger.Test(t), new(mockedRPCClient), config.ChainReader{})
+	require.NoError(t, err)
+
+	// the current value of a read is not an event sequence, so nothing is returned until events are indexed
+	_, err = svc.QueryKey(tests.Context(t), types.BoundContract{Name: Namespace}, query.KeyFilter{Key: "Lamports"}, query.LimitAndSort{}, &chainreader.NativeBalance{})
+	require.Error(t, err)
+}
+
 func newTestIDLAndCodec(t *testing.T) (string, codec.IDL, types.RemoteCodec) {
 	t.Helper()
 
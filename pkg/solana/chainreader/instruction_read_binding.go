@@ -0,0 +1,311 @@
+package chainreader
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	codeccommon "github.com/smartcontractkit/chainlink-common/pkg/codec"
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+// TransactionSimulator simulates transactions and is required for procedures that read the return
+// data of an instruction. This is likely a wrapper for a solana client.
+type TransactionSimulator interface {
+	SimulateTx(context.Context, *solana.Transaction, *rpc.SimulateTransactionOpts) (*rpc.SimulateTransactionResult, error)
+}
+
+const programReturnLogPrefix = "Program return: "
+
+// instructionAccount is an account of the simulated instruction. Accounts without a fixed address
+// are set to the bound address.
+type instructionAccount struct {
+	address  *solana.PublicKey
+	writable bool
+	signer   bool
+}
+
+// instructionReadBinding reads data by simulating an instruction and decoding the data it sets with
+// `set_return_data`. The `idlInstruction` refers to the instruction name in the IDL for which both
+// codecs have a type mapping.
+type instructionReadBinding struct {
+	idlInstruction string
+	programID      solana.PublicKey
+	feePayer       solana.PublicKey
+	accounts       []instructionAccount
+	args           types.RemoteCodec
+	returns        types.RemoteCodec
+	simulator      TransactionSimulator
+}
+
+func newInstructionReadBinding(idl codec.IDL, method config.ChainDataReader, procedure config.ChainReaderProcedure, simulator TransactionSimulator) (*instructionReadBinding, error) {
+	instruction, ok := findInstruction(idl, procedure.IDLInstruction)
+	if !ok {
+		return nil, fmt.Errorf("%w: instruction %s not found in IDL", types.ErrInvalidConfig, procedure.IDLInstruction)
+	}
+
+	if procedure.ReturnType != nil {
+		instruction.Returns = procedure.ReturnType
+	}
+
+	if instruction.Returns == nil {
+		return nil, fmt.Errorf("%w: instruction %s does not declare a return type", types.ErrInvalidConfig, instruction.Name)
+	}
+
+	programID, err := procedureAddress(procedure.ProgramID, idl.Address, "programID")
+	if err != nil {
+		return nil, err
+	}
+
+	feePayer, err := procedureAddress(procedure.FeePayer, "", "feePayer")
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := instructionAccounts(instruction.Accounts, procedure.Accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := config.BuilderForEncoding(method.Encoding)
+
+	// only the simulated instruction is encoded, so its return type can be set without affecting
+	// other procedures of the same instruction
+	idl.Instructions = []codec.IdlInstruction{instruction}
+
+	args, err := newModifiedCodec(idl, builder, codec.NewIDLInstructionCodec, instruction.Name, procedure.InputModifications)
+	if err != nil {
+		return nil, err
+	}
+
+	injectAddressModifier(procedure.OutputModifications)
+
+	returns, err := newModifiedCodec(idl, builder, codec.NewIDLInstructionReturnCodec, instruction.Name, procedure.OutputModifications)
+	if err != nil {
+		return nil, err
+	}
+
+	return &instructionReadBinding{
+		idlInstruction: instruction.Name,
+		programID:      programID,
+		feePayer:       feePayer,
+		accounts:       accounts,
+		args:           args,
+		returns:        returns,
+		simulator:      simulator,
+	}, nil
+}
+
+var _ readBinding = &instructionReadBinding{}
+
+// PreLoad is a no-op because the instruction data depends on the read params.
+func (b *instructionReadBinding) PreLoad(_ context.Context, _ string, _ primitives.ConfidenceLevel, _ *loadedResult) {
+}
+
+func (b *instructionReadBinding) GetLatestValue(ctx context.Context, address string, confidence primitives.ConfidenceLevel, params, outVal any, _ *loadedResult) error {
+	bound, err := solana.PublicKeyFromBase58(address)
+	if err != nil {
+		return err
+	}
+
+	commitment, err := commitmentForConfidence(confidence, "")
+	if err != nil {
+		return err
+	}
+
+	if params == nil {
+		if params, err = b.args.CreateType(b.idlInstruction, true); err != nil {
+			return err
+		}
+	}
+
+	data, err := b.args.Encode(ctx, params, b.idlInstruction)
+	if err != nil {
+		return err
+	}
+
+	tx, err := b.transaction(bound, data)
+	if err != nil {
+		return err
+	}
+
+	result, err := b.simulator.SimulateTx(ctx, tx, &rpc.SimulateTransactionOpts{
+		Commitment:             commitment,
+		ReplaceRecentBlockhash: true,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: failed to simulate instruction %s", err, b.idlInstruction)
+	}
+
+	if result.Err != nil {
+		return fmt.Errorf("simulation of instruction %s failed: %v", b.idlInstruction, result.Err)
+	}
+
+	returnData, err := b.returnData(result.Logs)
+	if err != nil {
+		return err
+	}
+
+	return b.returns.Decode(ctx, returnData, outVal, b.idlInstruction)
+}
+
+func (b *instructionReadBinding) CreateType(_ bool) (any, error) {
+	return b.returns.CreateType(b.idlInstruction, false)
+}
+
+func (b *instructionReadBinding) transaction(bound solana.PublicKey, data []byte) (*solana.Transaction, error) {
+	metas := make(solana.AccountMetaSlice, len(b.accounts))
+	for idx, account := range b.accounts {
+		address := bound
+		if account.address != nil {
+			address = *account.address
+		}
+
+		metas[idx] = solana.NewAccountMeta(address, account.writable, account.signer)
+	}
+
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{solana.NewInstruction(b.programID, metas, data)},
+		solana.Hash{}, // replaced by the simulation
+		solana.TransactionPayer(b.feePayer),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// signatures are not verified but a transaction needs one for each required signer
+	tx.Signatures = make([]solana.Signature, tx.Message.Header.NumRequiredSignatures)
+
+	return tx, nil
+}
+
+// returnData finds the data set by the program in the simulation logs. The runtime logs the data of
+// the last `set_return_data` call as `Program return: <program ID> <base64 data>`.
+func (b *instructionReadBinding) returnData(logs []string) ([]byte, error) {
+	prefix := programReturnLogPrefix + b.programID.String() + " "
+
+	for idx := len(logs) - 1; idx >= 0; idx-- {
+		if !strings.HasPrefix(logs[idx], prefix) {
+			continue
+		}
+
+		data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(logs[idx], prefix))
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid return data: %s", types.ErrInvalidEncoding, err)
+		}
+
+		return data, nil
+	}
+
+	return nil, fmt.Errorf("%w: instruction %s did not return data", types.ErrNotFound, b.idlInstruction)
+}
+
+// newModifiedCodec creates the codec of the IDL and applies the modifiers to the item type.
+func newModifiedCodec(
+	idl codec.IDL,
+	builder encodings.Builder,
+	newCodec func(codec.IDL, encodings.Builder) (types.RemoteCodec, error),
+	itemType string,
+	modifiers codeccommon.ModifiersConfig,
+) (types.RemoteCodec, error) {
+	idlCodec, err := newCodec(idl, builder)
+	if err != nil {
+		return nil, err
+	}
+
+	mod, err := modifiers.ToModifier(codec.DecoderHooks...)
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.NewNamedModifierCodec(idlCodec, itemType, mod)
+}
+
+func findInstruction(idl codec.IDL, name string) (codec.IdlInstruction, bool) {
+	for _, instruction := range idl.Instructions {
+		if instruction.Name == name {
+			return instruction, true
+		}
+	}
+
+	return codec.IdlInstruction{}, false
+}
+
+// instructionAccounts flattens the accounts of an instruction. The first account without a fixed
+// address in either the IDL or the config is left for the bound address.
+func instructionAccounts(items codec.IdlAccountItemSlice, fixed map[string]string) ([]instructionAccount, error) {
+	var (
+		accounts []instructionAccount
+		bound    bool
+		visit    func(codec.IdlAccountItemSlice) error
+	)
+
+	visit = func(items codec.IdlAccountItemSlice) error {
+		for _, item := range items {
+			if item.IdlAccounts != nil {
+				if err := visit(item.IdlAccounts.Accounts); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if item.IdlAccount == nil {
+				continue
+			}
+
+			account := instructionAccount{writable: item.IdlAccount.IsMut, signer: item.IdlAccount.IsSigner}
+
+			configured := fixed[item.IdlAccount.Name]
+			if configured == "" && item.IdlAccount.Address == "" && !bound {
+				bound = true
+				accounts = append(accounts, account)
+
+				continue
+			}
+
+			address, err := procedureAddress(configured, item.IdlAccount.Address, "account "+item.IdlAccount.Name)
+			if err != nil {
+				return err
+			}
+
+			account.address = &address
+			accounts = append(accounts, account)
+		}
+
+		return nil
+	}
+
+	if err := visit(items); err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// procedureAddress parses the configured address or falls back to the default.
+func procedureAddress(configured, fallback, name string) (solana.PublicKey, error) {
+	if configured == "" {
+		configured = fallback
+	}
+
+	if configured == "" {
+		return solana.PublicKey{}, fmt.Errorf("%w: %s is required", types.ErrInvalidConfig, name)
+	}
+
+	address, err := solana.PublicKeyFromBase58(configured)
+	if err != nil {
+		return solana.PublicKey{}, fmt.Errorf("%w: invalid %s: %s", types.ErrInvalidConfig, name, err)
+	}
+
+	return address, nil
+}
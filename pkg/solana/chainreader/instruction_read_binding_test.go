@@ -0,0 +1,272 @@
+package chainreader_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"sync"
+	"testing"
+
+	ag_solana "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	codeccommon "github.com/smartcontractkit/chainlink-common/pkg/codec"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/chainreader"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+func TestSolanaChainReaderService_InstructionProcedure(t *testing.T) {
+	t.Parallel()
+
+	programID := ag_solana.NewWallet().PublicKey()
+	feePayer := ag_solana.NewWallet().PublicKey()
+	feed := ag_solana.NewWallet().PublicKey()
+
+	returnType := func(t *testing.T, raw string) *codec.IdlType {
+		var idlType codec.IdlType
+		require.NoError(t, json.Unmarshal([]byte(raw), &idlType))
+
+		return &idlType
+	}
+
+	procedure := func(t *testing.T, scope map[string]any, returns string) config.ChainReaderProcedure {
+		proc := config.ChainReaderProcedure{
+			IDLInstruction: "query",
+			ReturnType:     returnType(t, returns),
+			ProgramID:      programID.String(),
+			FeePayer:       feePayer.String(),
+		}
+
+		if scope != nil {
+			proc.InputModifications = codeccommon.ModifiersConfig{
+				&codeccommon.HardCodeModifierConfig{OnChainValues: map[string]any{"Scope": scope}},
+			}
+		}
+
+		return proc
+	}
+
+	newConf := func(t *testing.T) config.ChainReader {
+		return config.ChainReader{Namespaces: map[string]config.ChainReaderMethods{
+			Namespace: {Methods: map[string]config.ChainDataReader{
+				"LatestRoundData": {AnchorIDL: storeQueryIDL, Procedures: []config.ChainReaderProcedure{
+					procedure(t, map[string]any{"LatestRoundData": map[string]any{}}, `{"defined": "Round"}`),
+				}},
+				"RoundData": {AnchorIDL: storeQueryIDL, Procedures: []config.ChainReaderProcedure{
+					procedure(t, nil, `{"defined": "Round"}`),
+				}},
+				"Decimals": {AnchorIDL: storeQueryIDL, Procedures: []config.ChainReaderProcedure{
+					procedure(t, map[string]any{"Decimals": map[string]any{}}, `"u8"`),
+				}},
+			}},
+		}}
+	}
+
+	newService := func(t *testing.T) (*chainreader.SolanaChainReaderService, *mockedSimulator, types.BoundContract) {
+		ctx := tests.Context(t)
+		client := &mockedSimulator{mockedRPCClient: new(mockedRPCClient)}
+
+		svc, err := chainreader.NewChainReaderService(logger.Test(t), client, newConf(t))
+		require.NoError(t, err)
+		require.NoError(t, svc.Start(ctx))
+
+		t.Cleanup(func() {
+			require.NoError(t, svc.Close())
+		})
+
+		addresses, err := json.Marshal(map[string][]string{
+			"LatestRoundData": {feed.String()},
+			"RoundData":       {feed.String()},
+			"Decimals":        {feed.String()},
+		})
+		require.NoError(t, err)
+
+		binding := types.BoundContract{Name: Namespace, Address: base64.StdEncoding.EncodeToString(addresses)}
+		require.NoError(t, svc.Bind(ctx, []types.BoundContract{binding}))
+
+		return svc, client, binding
+	}
+
+	type round struct {
+		RoundID   uint32
+		Slot      uint64
+		Timestamp uint32
+		Answer    *big.Int
+	}
+
+	// round 7 at slot 8 with timestamp 9 and answer 10
+	encodedRound := []byte{7, 0, 0, 0, 8, 0, 0, 0, 0, 0, 0, 0, 9, 0, 0, 0, 10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
+	expectedRound := round{RoundID: 7, Slot: 8, Timestamp: 9, Answer: big.NewInt(10)}
+	queryDiscriminator := []byte{39, 251, 130, 159, 46, 136, 164, 169}
+
+	t.Run("latest round data", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := tests.Context(t)
+		svc, client, binding := newService(t)
+		client.setReturnData(programID, encodedRound)
+
+		var result round
+		require.NoError(t, svc.GetLatestValue(ctx, binding.ReadIdentifier("LatestRoundData"), primitives.Finalized, nil, &result))
+		assert.Equal(t, expectedRound, result)
+
+		tx, opts := client.last()
+		assert.False(t, opts.SigVerify)
+		assert.True(t, opts.ReplaceRecentBlockhash)
+		assert.Equal(t, rpc.CommitmentFinalized, opts.Commitment)
+
+		assert.Equal(t, feePayer, tx.Message.AccountKeys[0])
+		require.Len(t, tx.Signatures, 1)
+		require.Len(t, tx.Message.Instructions, 1)
+
+		instruction := tx.Message.Instructions[0]
+		assert.Equal(t, programID, tx.Message.AccountKeys[instruction.ProgramIDIndex])
+		require.Len(t, instruction.Accounts, 1)
+		assert.Equal(t, feed, tx.Message.AccountKeys[instruction.Accounts[0]])
+		assert.Equal(t, append(queryDiscriminator, 4), []byte(instruction.Data))
+	})
+
+	t.Run("round data with params", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := tests.Context(t)
+		svc, client, binding := newService(t)
+		client.setReturnData(programID, encodedRound)
+
+		params := map[string]any{"Scope": map[string]any{"RoundData": map[string]any{"RoundId": 7}}}
+
+		var result round
+		require.NoError(t, svc.GetLatestValue(ctx, binding.ReadIdentifier("RoundData"), primitives.Unconfirmed, params, &result))
+		assert.Equal(t, expectedRound, result)
+
+		tx, opts := client.last()
+		assert.Equal(t, rpc.CommitmentConfirmed, opts.Commitment)
+		assert.Equal(t, append(queryDiscriminator, 3, 7, 0, 0, 0), []byte(tx.Message.Instructions[0].Data))
+	})
+
+	t.Run("decimals", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := tests.Context(t)
+		svc, client, binding := newService(t)
+		client.setReturnData(programID, []byte{8})
+
+		var decimals uint8
+		require.NoError(t, svc.GetLatestValue(ctx, binding.ReadIdentifier("Decimals"), primitives.Unconfirmed, nil, &decimals))
+		assert.Equal(t, uint8(8), decimals)
+	})
+
+	t.Run("missing return data", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := tests.Context(t)
+		svc, client, binding := newService(t)
+		client.setReturnData(ag_solana.NewWallet().PublicKey(), []byte{8})
+
+		var decimals uint8
+		require.ErrorIs(t, svc.GetLatestValue(ctx, binding.ReadIdentifier("Decimals"), primitives.Unconfirmed, nil, &decimals), types.ErrNotFound)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		t.Parallel()
+
+		for name, modify := range map[string]func(*config.ChainReaderProcedure){
+			"missing fee payer":   func(proc *config.ChainReaderProcedure) { proc.FeePayer = "" },
+			"missing program ID":  func(proc *config.ChainReaderProcedure) { proc.ProgramID = "" },
+			"missing return type": func(proc *config.ChainReaderProcedure) { proc.ReturnType = nil },
+			"unknown instruction": func(proc *config.ChainReaderProcedure) { proc.IDLInstruction = "unknown" },
+		} {
+			t.Run(name, func(t *testing.T) {
+				conf := newConf(t)
+				modify(&conf.Namespaces[Namespace].Methods["Decimals"].Procedures[0])
+
+				_, err := chainreader.NewChainReaderService(logger.Test(t), &mockedSimulator{mockedRPCClient: new(mockedRPCClient)}, conf)
+				require.ErrorIs(t, err, types.ErrInvalidConfig)
+			})
+		}
+
+		_, err := chainreader.NewChainReaderService(logger.Test(t), new(mockedRPCClient), newConf(t))
+		require.ErrorIs(t, err, types.ErrInvalidConfig)
+	})
+}
+
+type mockedSimulator struct {
+	*mockedRPCClient
+
+	mu   sync.Mutex
+	logs []string
+	tx   *ag_solana.Transaction
+	opts *rpc.SimulateTransactionOpts
+}
+
+func (m *mockedSimulator) SimulateTx(_ context.Context, tx *ag_solana.Transaction, opts *rpc.SimulateTransactionOpts) (*rpc.SimulateTransactionResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.tx, m.opts = tx, opts
+
+	return &rpc.SimulateTransactionResult{Logs: m.logs}, nil
+}
+
+func (m *mockedSimulator) setReturnData(programID ag_solana.PublicKey, data []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.logs = []string{
+		"Program " + programID.String() + " invoke [1]",
+		"Program return: " + programID.String() + " " + base64.StdEncoding.EncodeToString(data),
+		"Program " + programID.String() + " success",
+	}
+}
+
+func (m *mockedSimulator) last() (*ag_solana.Transaction, *rpc.SimulateTransactionOpts) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.tx, m.opts
+}
+
+// the query instruction of the store program, which does not declare its return types
+const storeQueryIDL = `{
+	"version": "1.0.0",
+	"name": "store",
+	"instructions": [{
+		"name": "query",
+		"accounts": [{"name": "feed", "isMut": false, "isSigner": false}],
+		"args": [{"name": "scope", "type": {"defined": "Scope"}}]
+	}],
+	"types": [{
+		"name": "Round",
+		"type": {
+			"kind": "struct",
+			"fields": [
+				{"name": "roundId", "type": "u32"},
+				{"name": "slot", "type": "u64"},
+				{"name": "timestamp", "type": "u32"},
+				{"name": "answer", "type": "i128"}
+			]
+		}
+	}, {
+		"name": "Scope",
+		"type": {
+			"kind": "enum",
+			"variants": [
+				{"name": "Version"},
+				{"name": "Decimals"},
+				{"name": "Description"},
+				{"name": "RoundData", "fields": [{"name": "roundId", "type": "u32"}]},
+				{"name": "LatestRoundData"},
+				{"name": "Aggregator"}
+			]
+		}
+	}]
+}`
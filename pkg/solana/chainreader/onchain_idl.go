@@ -0,0 +1,263 @@
+package chainreader
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	ag_solana "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/jpillora/backoff"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+const (
+	onChainIDLRetryMin = time.Second
+	onChainIDLRetryMax = time.Minute
+)
+
+// onChainIDLMethod is a configured method without an inline IDL. Its read bindings are added once
+// the IDL of the program is fetched.
+type onChainIDLMethod struct {
+	namespace string
+	name      string
+	method    config.ChainDataReader
+	// err is the last failure to add the method. Invalid config failures are not retried.
+	err error
+}
+
+func (m *onChainIDLMethod) retryable() bool {
+	return !errors.Is(m.err, types.ErrInvalidConfig)
+}
+
+// onChainIDLProgram holds the pending methods of a program with an on-chain IDL. Programs are loaded
+// independently, so a program that cannot be loaded does not hold back the methods of other programs.
+type onChainIDLProgram struct {
+	id ag_solana.PublicKey
+	// loadLock serializes loads of the program so concurrent reads do not fetch the same IDL
+	loadLock sync.Mutex
+	// methods that are still pending, guarded by SolanaChainReaderService.pendingIDLLock
+	methods []*onChainIDLMethod
+}
+
+func (s *SolanaChainReaderService) addOnChainIDLMethod(namespace, methodName string, method config.ChainDataReader) error {
+	programID, err := ag_solana.PublicKeyFromBase58(method.OnChainIDL.ProgramID)
+	if err != nil {
+		return fmt.Errorf("%w: invalid program ID for %s.%s: %s", types.ErrInvalidConfig, namespace, methodName, err)
+	}
+
+	program, ok := s.onChainIDLPrograms[programID]
+	if !ok {
+		program = &onChainIDLProgram{id: programID}
+		s.onChainIDLPrograms[programID] = program
+	}
+
+	program.methods = append(program.methods, &onChainIDLMethod{
+		namespace: namespace,
+		name:      methodName,
+		method:    method,
+	})
+
+	return nil
+}
+
+// loadOnChainIDLs loads the IDL of each program with pending methods and returns the failures of all
+// methods that are still pending.
+func (s *SolanaChainReaderService) loadOnChainIDLs(ctx context.Context) error {
+	var errs []error
+
+	for _, program := range s.onChainIDLPrograms {
+		s.loadOnChainIDL(ctx, program)
+
+		for _, pending := range s.pendingOnChainIDLMethods(program, nil) {
+			errs = append(errs, pending.err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// loadOnChainIDL fetches, verifies and parses the IDL of the program without holding idlLock, which is
+// only taken to add the read bindings of each pending method. Methods that are added are no longer pending.
+func (s *SolanaChainReaderService) loadOnChainIDL(ctx context.Context, program *onChainIDLProgram) {
+	program.loadLock.Lock()
+	defer program.loadLock.Unlock()
+
+	pending := s.pendingOnChainIDLMethods(program, (*onChainIDLMethod).retryable)
+	if len(pending) == 0 {
+		return
+	}
+
+	var idl codec.IDL
+
+	raw, err := fetchOnChainIDL(ctx, s.client, program.id)
+	if err != nil {
+		err = fmt.Errorf("failed to fetch IDL of program %s: %w", program.id, err)
+	} else if parseErr := json.Unmarshal(raw, &idl); parseErr != nil {
+		err = fmt.Errorf("%w: failed to parse IDL of program %s: %s", types.ErrInvalidConfig, program.id, parseErr)
+	}
+
+	for _, method := range pending {
+		methodErr := err
+		if methodErr == nil {
+			if methodErr = verifyIDLHash(raw, method.method.OnChainIDL.Hash); methodErr != nil {
+				methodErr = fmt.Errorf("%w for %s.%s", methodErr, method.namespace, method.name)
+			}
+		}
+
+		if methodErr == nil {
+			s.idlLock.Lock()
+			methodErr = s.addMethod(method.namespace, method.name, idl, method.method)
+			s.idlLock.Unlock()
+		}
+
+		s.settleOnChainIDLMethod(program, method, methodErr)
+	}
+}
+
+// ensureOnChainIDL loads the on-chain IDLs of the pending methods of the namespace. All methods of the
+// namespace are checked if methodName is empty. Methods with an invalid IDL config fail without a fetch.
+func (s *SolanaChainReaderService) ensureOnChainIDL(ctx context.Context, namespace, methodName string) error {
+	matches := func(pending *onChainIDLMethod) bool {
+		return pending.namespace == namespace && (methodName == "" || pending.name == methodName)
+	}
+
+	var errs []error
+
+	for _, program := range s.onChainIDLPrograms {
+		if len(s.pendingOnChainIDLMethods(program, matches)) == 0 {
+			continue
+		}
+
+		s.loadOnChainIDL(ctx, program)
+
+		for _, pending := range s.pendingOnChainIDLMethods(program, matches) {
+			errs = append(errs, pending.err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("on-chain IDL of %s is not loaded: %w", namespace, err)
+	}
+
+	return nil
+}
+
+// pendingOnChainIDLMethods returns the pending methods of the program accepted by keep, or all if keep is nil.
+func (s *SolanaChainReaderService) pendingOnChainIDLMethods(program *onChainIDLProgram, keep func(*onChainIDLMethod) bool) []*onChainIDLMethod {
+	s.pendingIDLLock.Lock()
+	defer s.pendingIDLLock.Unlock()
+
+	var methods []*onChainIDLMethod
+
+	for _, pending := range program.methods {
+		if keep == nil || keep(pending) {
+			methods = append(methods, pending)
+		}
+	}
+
+	return methods
+}
+
+// settleOnChainIDLMethod records the failure of the method or removes it from the pending methods.
+func (s *SolanaChainReaderService) settleOnChainIDLMethod(program *onChainIDLProgram, method *onChainIDLMethod, err error) {
+	s.pendingIDLLock.Lock()
+	defer s.pendingIDLLock.Unlock()
+
+	if err != nil {
+		method.err = err
+
+		return
+	}
+
+	for idx, pending := range program.methods {
+		if pending == method {
+			program.methods = append(program.methods[:idx], program.methods[idx+1:]...)
+
+			return
+		}
+	}
+}
+
+// retryableOnChainIDLs returns true if a pending method failed with an error that may be resolved by a refetch.
+func (s *SolanaChainReaderService) retryableOnChainIDLs() bool {
+	for _, program := range s.onChainIDLPrograms {
+		if len(s.pendingOnChainIDLMethods(program, (*onChainIDLMethod).retryable)) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// retryOnChainIDLs loads the on-chain IDLs with backoff until all retryable methods are loaded or the
+// service is closed.
+func (s *SolanaChainReaderService) retryOnChainIDLs() {
+	defer s.wg.Done()
+
+	ctx, cancel := s.stopCh.NewCtx()
+	defer cancel()
+
+	retry := &backoff.Backoff{Min: onChainIDLRetryMin, Max: onChainIDLRetryMax, Jitter: true}
+
+	for s.retryableOnChainIDLs() {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retry.Duration()):
+		}
+
+		err := s.loadOnChainIDLs(ctx)
+		if err == nil {
+			s.lggr.Info("Loaded on-chain IDLs")
+
+			return
+		}
+
+		if ctx.Err() == nil {
+			s.lggr.Warnw("Failed to load on-chain IDLs, retrying", "err", err)
+		}
+	}
+}
+
+// fetchOnChainIDL reads the finalized IDL account of the program and returns the decompressed IDL JSON.
+func fetchOnChainIDL(ctx context.Context, reader BinaryDataReader, programID ag_solana.PublicKey) ([]byte, error) {
+	address, err := codec.IDLAddress(programID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := reader.ReadAll(ctx, address, &rpc.GetAccountInfoOpts{
+		Encoding:   ag_solana.EncodingBase64,
+		Commitment: rpc.CommitmentFinalized,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.DecodeIDLAccount(data)
+}
+
+// verifyIDLHash compares the sha256 hash of the raw IDL with the expected hex encoded hash if one is set.
+func verifyIDLHash(raw []byte, expected string) error {
+	if expected == "" {
+		return nil
+	}
+
+	sum := sha256.Sum256(raw)
+	if actual := hex.EncodeToString(sum[:]); actual != strings.ToLower(strings.TrimPrefix(expected, "0x")) {
+		return fmt.Errorf("%w: IDL hash %s does not match expected %s", types.ErrInvalidConfig, actual, expected)
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+package chainreader_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	ag_solana "github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/chainreader"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec/testutils"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+func TestSolanaChainReaderService_OnChainIDL(t *testing.T) {
+	t.Parallel()
+
+	const otherNamespace = "OtherNamespace"
+
+	programID := ag_solana.NewWallet().PublicKey()
+	idlAddress, err := codec.IDLAddress(programID)
+	require.NoError(t, err)
+
+	sum := sha256.Sum256([]byte(testutils.JSONIDLWithAllTypes))
+	idlHash := hex.EncodeToString(sum[:])
+
+	newConf := func(t *testing.T, hash string) (types.RemoteCodec, config.ChainReader) {
+		testCodec, conf := newTestConfAndCodec(t)
+
+		method := conf.Namespaces[Namespace].Methods[NamedMethod]
+		method.AnchorIDL = ""
+		method.OnChainIDL = &config.OnChainIDL{ProgramID: programID.String(), Hash: hash}
+		conf.Namespaces[Namespace].Methods[NamedMethod] = method
+
+		return testCodec, conf
+	}
+
+	t.Run("reads with the fetched IDL", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := tests.Context(t)
+		testCodec, conf := newConf(t, idlHash)
+
+		client := new(mockedRPCClient)
+		client.SetForAddress(idlAddress, testutils.EncodeIDLAccount([]byte(testutils.JSONIDLWithAllTypes)), nil, 0)
+
+		svc, err := chainreader.NewChainReaderService(logger.Test(t), client, conf)
+		require.NoError(t, err)
+		require.NoError(t, svc.Start(ctx))
+
+		t.Cleanup(func() {
+			require.NoError(t, svc.Close())
+		})
+
+		account := ag_solana.NewWallet().PublicKey()
+		addresses, err := json.Marshal(map[string][]string{NamedMethod: {account.String()}})
+		require.NoError(t, err)
+
+		binding := types.BoundContract{Name: Namespace, Address: base64.StdEncoding.EncodeToString(addresses)}
+		require.NoError(t, svc.Bind(ctx, []types.BoundContract{binding}))
+
+		encoded, err := testCodec.Encode(ctx, testutils.DefaultTestStruct, testutils.TestStructWithNestedStruct)
+		require.NoError(t, err)
+		client.SetForAddress(account, encoded, nil, 0)
+
+		var result modifiedStructWithNestedStruct
+		require.NoError(t, svc.GetLatestValue(ctx, binding.ReadIdentifier(NamedMethod), primitives.Unconfirmed, nil, &result))
+		assert.Equal(t, testutils.DefaultTestStruct.Value, result.V)
+		assert.Equal(t, testutils.DefaultTestStruct.InnerStruct, result.InnerStruct)
+	})
+
+	t.Run("fetch failures are retried", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := tests.Context(t)
+		testCodec, conf := newConf(t, idlHash)
+
+		client := new(mockedRPCClient)
+		client.SetForAddress(idlAddress, nil, errors.New("transient RPC failure"), 0)
+
+		svc, err := chainreader.NewChainReaderService(logger.Test(t), client, conf)
+		require.NoError(t, err)
+		require.NoError(t, svc.Start(ctx))
+
+		t.Cleanup(func() {
+			require.NoError(t, svc.Close())
+		})
+
+		account := ag_solana.NewWallet().PublicKey()
+		addresses, err := json.Marshal(map[string][]string{NamedMethod: {account.String()}})
+		require.NoError(t, err)
+
+		// the mocked client has no response for the IDL account
+		binding := types.BoundContract{Name: Namespace, Address: base64.StdEncoding.EncodeToString(addresses)}
+		require.ErrorContains(t, svc.Bind(ctx, []types.BoundContract{binding}), "on-chain IDL of "+Namespace+" is not loaded")
+
+		client.SetForAddress(idlAddress, testutils.EncodeIDLAccount([]byte(testutils.JSONIDLWithAllTypes)), nil, 0)
+		require.NoError(t, svc.Bind(ctx, []types.BoundContract{binding}))
+
+		encoded, err := testCodec.Encode(ctx, testutils.DefaultTestStruct, testutils.TestStructWithNestedStruct)
+		require.NoError(t, err)
+		client.SetForAddress(account, encoded, nil, 0)
+
+		var result modifiedStructWithNestedStruct
+		require.NoError(t, svc.GetLatestValue(ctx, binding.ReadIdentifier(NamedMethod), primitives.Unconfirmed, nil, &result))
+		assert.Equal(t, testutils.DefaultTestStruct.Value, result.V)
+	})
+
+	t.Run("pinned hash mismatch fails reads of the method only", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := tests.Context(t)
+		testCodec, conf := newConf(t, hex.EncodeToString(make([]byte, sha256.Size)))
+
+		// a second namespace reads with the IDL of another program that matches its pinned hash
+		otherProgramID := ag_solana.NewWallet().PublicKey()
+		otherIDLAddress, err := codec.IDLAddress(otherProgramID)
+		require.NoError(t, err)
+
+		otherMethod := conf.Namespaces[Namespace].Methods[NamedMethod]
+		otherMethod.OnChainIDL = &config.OnChainIDL{ProgramID: otherProgramID.String(), Hash: idlHash}
+		conf.Namespaces[otherNamespace] = config.ChainReaderMethods{
+			Methods: map[string]config.ChainDataReader{NamedMethod: otherMethod},
+		}
+
+		client := new(mockedRPCClient)
+		client.SetForAddress(idlAddress, testutils.EncodeIDLAccount([]byte(testutils.JSONIDLWithAllTypes)), nil, 0)
+		client.SetForAddress(otherIDLAddress, testutils.EncodeIDLAccount([]byte(testutils.JSONIDLWithAllTypes)), nil, 0)
+
+		svc, err := chainreader.NewChainReaderService(logger.Test(t), client, conf)
+		require.NoError(t, err)
+		require.NoError(t, svc.Start(ctx))
+
+		t.Cleanup(func() {
+			require.NoError(t, svc.Close())
+		})
+
+		account := ag_solana.NewWallet().PublicKey()
+		addresses, err := json.Marshal(map[string][]string{NamedMethod: {account.String()}})
+		require.NoError(t, err)
+
+		// the mismatch is not refetched, the mocked client has no more responses for the IDL account
+		binding := types.BoundContract{Name: Namespace, Address: base64.StdEncoding.EncodeToString(addresses)}
+		require.ErrorIs(t, svc.Bind(ctx, []types.BoundContract{binding}), types.ErrInvalidConfig)
+		require.ErrorIs(t, svc.Bind(ctx, []types.BoundContract{binding}), types.ErrInvalidConfig)
+
+		otherBinding := types.BoundContract{Name: otherNamespace, Address: base64.StdEncoding.EncodeToString(addresses)}
+		require.NoError(t, svc.Bind(ctx, []types.BoundContract{otherBinding}))
+
+		encoded, err := testCodec.Encode(ctx, testutils.DefaultTestStruct, testutils.TestStructWithNestedStruct)
+		require.NoError(t, err)
+		client.SetForAddress(account, encoded, nil, 0)
+
+		var result modifiedStructWithNestedStruct
+		require.NoError(t, svc.GetLatestValue(ctx, otherBinding.ReadIdentifier(NamedMethod), primitives.Unconfirmed, nil, &result))
+		assert.Equal(t, testutils.DefaultTestStruct.Value, result.V)
+	})
+
+	t.Run("inline and on-chain IDL are exclusive", func(t *testing.T) {
+		t.Parallel()
+
+		_, conf := newConf(t, "")
+
+		method := conf.Namespaces[Namespace].Methods[NamedMethod]
+		method.AnchorIDL = testutils.JSONIDLWithAllTypes
+		conf.Namespaces[Namespace].Methods[NamedMethod] = method
+
+		_, err := chainreader.NewChainReaderService(logger.Test(t), new(mockedRPCClient), conf)
+		require.ErrorIs(t, err, types.ErrInvalidConfig)
+	})
+}
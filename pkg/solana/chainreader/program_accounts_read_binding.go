@@ -0,0 +1,240 @@
+package chainreader
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/mr-tron/base58"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+// ProgramAccountsReader lists the accounts owned by a program and is required for procedures that
+// scan program accounts. This is likely a wrapper for a solana client.
+type ProgramAccountsReader interface {
+	GetProgramAccountsWithOpts(context.Context, solana.PublicKey, *rpc.GetProgramAccountsOpts) (rpc.GetProgramAccountsResult, error)
+}
+
+// programAccountsReadBinding lists all accounts of the bound program that match the filters and
+// decodes them into a slice. The `idlAccount` refers to the account name in the IDL for which the
+// codec has a type mapping.
+type programAccountsReadBinding struct {
+	idlAccount    string
+	codec         types.RemoteCodec
+	reader        ProgramAccountsReader
+	discriminator []byte
+	dataSize      *uint64
+	memcmp        []memcmpFilter
+}
+
+// memcmpFilter matches either fixed bytes or the bytes of a read param.
+type memcmpFilter struct {
+	offset uint64
+	bytes  solana.Base58
+	param  string
+}
+
+func newProgramAccountsReadBinding(
+	idl codec.IDL,
+	method config.ChainDataReader,
+	procedure config.ChainReaderProcedure,
+	idlCodec types.RemoteCodec,
+	reader ProgramAccountsReader,
+) (*programAccountsReadBinding, error) {
+	binding := &programAccountsReadBinding{
+		idlAccount: procedure.IDLAccount,
+		codec:      idlCodec,
+		reader:     reader,
+		dataSize:   procedure.ProgramAccounts.DataSize,
+	}
+
+	if !method.DisableDiscriminator {
+		discriminator, err := codec.AccountDiscriminator(idl, procedure.IDLAccount)
+		if err != nil {
+			return nil, err
+		}
+
+		binding.discriminator = discriminator
+	}
+
+	for _, filter := range procedure.ProgramAccounts.Memcmp {
+		if (filter.Bytes == "") == (filter.Param == "") {
+			return nil, fmt.Errorf("%w: memcmp filter at offset %d requires exactly one of bytes and param", types.ErrInvalidConfig, filter.Offset)
+		}
+
+		memcmp := memcmpFilter{offset: filter.Offset, param: filter.Param}
+
+		if filter.Bytes != "" {
+			decoded, err := base58.Decode(filter.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid memcmp bytes: %s", types.ErrInvalidConfig, err)
+			}
+
+			memcmp.bytes = decoded
+		}
+
+		binding.memcmp = append(binding.memcmp, memcmp)
+	}
+
+	return binding, nil
+}
+
+var _ readBinding = &programAccountsReadBinding{}
+
+// PreLoad is a no-op because the filters depend on the read params.
+func (b *programAccountsReadBinding) PreLoad(_ context.Context, _ string, _ primitives.ConfidenceLevel, _ *loadedResult) {
+}
+
+func (b *programAccountsReadBinding) GetLatestValue(ctx context.Context, address string, confidence primitives.ConfidenceLevel, params, outVal any, _ *loadedResult) error {
+	programID, err := solana.PublicKeyFromBase58(address)
+	if err != nil {
+		return err
+	}
+
+	commitment, err := commitmentForConfidence(confidence, "")
+	if err != nil {
+		return err
+	}
+
+	filters, err := b.filters(params)
+	if err != nil {
+		return err
+	}
+
+	accounts, err := b.reader.GetProgramAccountsWithOpts(ctx, programID, &rpc.GetProgramAccountsOpts{
+		Commitment: commitment,
+		Encoding:   solana.EncodingBase64,
+		Filters:    filters,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: failed to get program accounts", err)
+	}
+
+	// the RPC does not order accounts
+	sort.Slice(accounts, func(i, j int) bool {
+		return bytes.Compare(accounts[i].Pubkey[:], accounts[j].Pubkey[:]) < 0
+	})
+
+	tSlice := reflect.TypeOf(outVal)
+	if tSlice.Kind() != reflect.Pointer || tSlice.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("%w: program accounts are returned as a pointer to a slice, got %T", types.ErrInvalidType, outVal)
+	}
+
+	tElem := tSlice.Elem().Elem()
+	result := reflect.MakeSlice(tSlice.Elem(), 0, len(accounts))
+
+	for _, account := range accounts {
+		if account.Account == nil || account.Account.Data == nil {
+			continue
+		}
+
+		item := reflect.New(tElem)
+		if err = b.codec.Decode(ctx, account.Account.Data.GetBinary(), item.Interface(), b.idlAccount); err != nil {
+			return fmt.Errorf("failed to decode account %s: %w", account.Pubkey, err)
+		}
+
+		result = reflect.Append(result, item.Elem())
+	}
+
+	reflect.ValueOf(outVal).Elem().Set(result)
+
+	return nil
+}
+
+func (b *programAccountsReadBinding) CreateType(_ bool) (any, error) {
+	item, err := b.codec.CreateType(b.idlAccount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return reflect.New(reflect.SliceOf(reflect.TypeOf(item).Elem())).Interface(), nil
+}
+
+func (b *programAccountsReadBinding) filters(params any) ([]rpc.RPCFilter, error) {
+	var filters []rpc.RPCFilter
+
+	if len(b.discriminator) > 0 {
+		filters = append(filters, rpc.RPCFilter{Memcmp: &rpc.RPCFilterMemcmp{Offset: 0, Bytes: b.discriminator}})
+	}
+
+	if b.dataSize != nil {
+		filters = append(filters, rpc.RPCFilter{DataSize: *b.dataSize})
+	}
+
+	for _, filter := range b.memcmp {
+		match := filter.bytes
+
+		if filter.param != "" {
+			// querying without the filter would return all accounts of the program
+			value, ok := paramValue(params, filter.param)
+			if !ok {
+				return nil, fmt.Errorf("%w: missing param %s for memcmp filter", types.ErrInvalidType, filter.param)
+			}
+
+			var err error
+			if match, err = memcmpBytes(value); err != nil {
+				return nil, fmt.Errorf("%w for param %s", err, filter.param)
+			}
+		}
+
+		filters = append(filters, rpc.RPCFilter{Memcmp: &rpc.RPCFilterMemcmp{Offset: filter.offset, Bytes: match}})
+	}
+
+	return filters, nil
+}
+
+// paramValue finds a param by case-insensitive name in a map or struct.
+func paramValue(params any, name string) (any, bool) {
+	if params == nil {
+		return nil, false
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(params))
+
+	switch value.Kind() {
+	case reflect.Map:
+		for _, key := range value.MapKeys() {
+			if key.Kind() == reflect.String && strings.EqualFold(key.String(), name) {
+				return value.MapIndex(key).Interface(), true
+			}
+		}
+	case reflect.Struct:
+		field := value.FieldByNameFunc(func(field string) bool { return strings.EqualFold(field, name) })
+		if field.IsValid() && field.CanInterface() {
+			return field.Interface(), true
+		}
+	default:
+	}
+
+	return nil, false
+}
+
+func memcmpBytes(value any) (solana.Base58, error) {
+	switch typed := value.(type) {
+	case solana.PublicKey:
+		return typed[:], nil
+	case [32]byte:
+		return typed[:], nil
+	case []byte:
+		return typed, nil
+	case string:
+		address, err := solana.PublicKeyFromBase58(typed)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", types.ErrInvalidType, err)
+		}
+
+		return address[:], nil
+	default:
+		return nil, fmt.Errorf("%w: memcmp bytes must be an address or byte slice, got %T", types.ErrInvalidType, value)
+	}
+}
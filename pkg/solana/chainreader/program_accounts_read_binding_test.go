@@ -0,0 +1,190 @@
+package chainreader_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	ag_solana "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/chainreader"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec/testutils"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+func TestSolanaChainReaderService_ProgramAccountsProcedure(t *testing.T) {
+	t.Parallel()
+
+	programID := ag_solana.NewWallet().PublicKey()
+	fixed := ag_solana.NewWallet().PublicKey()
+
+	newConf := func(t *testing.T) (types.RemoteCodec, config.ChainReader) {
+		testCodec, conf := newTestConfAndCodec(t)
+
+		method := conf.Namespaces[Namespace].Methods[NamedMethod]
+		method.Procedures[0].ProgramAccounts = &config.ProgramAccountsOpts{
+			Memcmp: []config.MemcmpFilter{
+				{Offset: 40, Param: "Owner"},
+				{Offset: 72, Bytes: fixed.String()},
+			},
+		}
+		conf.Namespaces[Namespace].Methods[NamedMethod] = method
+
+		return testCodec, conf
+	}
+
+	newService := func(t *testing.T, conf config.ChainReader) (*chainreader.SolanaChainReaderService, *mockedProgramAccountsReader, types.BoundContract) {
+		ctx := tests.Context(t)
+		client := &mockedProgramAccountsReader{mockedRPCClient: new(mockedRPCClient)}
+
+		svc, err := chainreader.NewChainReaderService(logger.Test(t), client, conf)
+		require.NoError(t, err)
+		require.NoError(t, svc.Start(ctx))
+
+		t.Cleanup(func() {
+			require.NoError(t, svc.Close())
+		})
+
+		addresses, err := json.Marshal(map[string][]string{NamedMethod: {programID.String()}})
+		require.NoError(t, err)
+
+		binding := types.BoundContract{Name: Namespace, Address: base64.StdEncoding.EncodeToString(addresses)}
+		require.NoError(t, svc.Bind(ctx, []types.BoundContract{binding}))
+
+		return svc, client, binding
+	}
+
+	t.Run("decodes all matching accounts", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := tests.Context(t)
+		testCodec, conf := newConf(t)
+		svc, client, binding := newService(t, conf)
+
+		first, second := testutils.DefaultTestStruct, testutils.DefaultTestStruct
+		first.Value, second.Value = 1, 2
+
+		firstEncoded, err := testCodec.Encode(ctx, first, testutils.TestStructWithNestedStruct)
+		require.NoError(t, err)
+
+		secondEncoded, err := testCodec.Encode(ctx, second, testutils.TestStructWithNestedStruct)
+		require.NoError(t, err)
+
+		// accounts are returned in address order
+		client.setAccounts(map[ag_solana.PublicKey][]byte{
+			{2}: secondEncoded,
+			{1}: firstEncoded,
+		})
+
+		owner := ag_solana.NewWallet().PublicKey()
+
+		var result []modifiedStructWithNestedStruct
+		require.NoError(t, svc.GetLatestValue(ctx, binding.ReadIdentifier(NamedMethod), primitives.Finalized, map[string]any{"owner": owner}, &result))
+		require.Len(t, result, 2)
+		assert.Equal(t, uint8(1), result[0].V)
+		assert.Equal(t, uint8(2), result[1].V)
+		assert.Equal(t, first.InnerStruct, result[0].InnerStruct)
+
+		var idl codec.IDL
+		require.NoError(t, json.Unmarshal([]byte(testutils.JSONIDLWithAllTypes), &idl))
+
+		discriminator, err := codec.AccountDiscriminator(idl, testutils.TestStructWithNestedStruct)
+		require.NoError(t, err)
+
+		gotProgramID, opts := client.last()
+		assert.Equal(t, programID, gotProgramID)
+		assert.Equal(t, rpc.CommitmentFinalized, opts.Commitment)
+		assert.Equal(t, []rpc.RPCFilter{
+			{Memcmp: &rpc.RPCFilterMemcmp{Offset: 0, Bytes: ag_solana.Base58(discriminator)}},
+			{Memcmp: &rpc.RPCFilterMemcmp{Offset: 40, Bytes: owner[:]}},
+			{Memcmp: &rpc.RPCFilterMemcmp{Offset: 72, Bytes: fixed[:]}},
+		}, opts.Filters)
+
+		var value values.Value
+		require.NoError(t, svc.GetLatestValue(ctx, binding.ReadIdentifier(NamedMethod), primitives.Unconfirmed, map[string]any{"owner": owner}, &value))
+
+		var fromValue []modifiedStructWithNestedStruct
+		require.NoError(t, value.UnwrapTo(&fromValue))
+		require.Len(t, fromValue, 2)
+	})
+
+	t.Run("missing filter param", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := tests.Context(t)
+		_, conf := newConf(t)
+		svc, client, binding := newService(t, conf)
+
+		var result []modifiedStructWithNestedStruct
+		require.ErrorIs(t, svc.GetLatestValue(ctx, binding.ReadIdentifier(NamedMethod), primitives.Unconfirmed, nil, &result), types.ErrInvalidType)
+		require.ErrorIs(t, svc.GetLatestValue(ctx, binding.ReadIdentifier(NamedMethod), primitives.Unconfirmed, map[string]any{"other": 1}, &result), types.ErrInvalidType)
+
+		// no unfiltered query is made
+		_, opts := client.last()
+		assert.Nil(t, opts)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		t.Parallel()
+
+		_, conf := newConf(t)
+		conf.Namespaces[Namespace].Methods[NamedMethod].Procedures[0].ProgramAccounts.Memcmp[1].Bytes = "0OIl"
+
+		_, err := chainreader.NewChainReaderService(logger.Test(t), &mockedProgramAccountsReader{mockedRPCClient: new(mockedRPCClient)}, conf)
+		require.ErrorIs(t, err, types.ErrInvalidConfig)
+
+		_, conf = newConf(t)
+		_, err = chainreader.NewChainReaderService(logger.Test(t), new(mockedRPCClient), conf)
+		require.ErrorIs(t, err, types.ErrInvalidConfig)
+	})
+}
+
+type mockedProgramAccountsReader struct {
+	*mockedRPCClient
+
+	mu        sync.Mutex
+	accounts  rpc.GetProgramAccountsResult
+	programID ag_solana.PublicKey
+	opts      *rpc.GetProgramAccountsOpts
+}
+
+func (m *mockedProgramAccountsReader) GetProgramAccountsWithOpts(_ context.Context, programID ag_solana.PublicKey, opts *rpc.GetProgramAccountsOpts) (rpc.GetProgramAccountsResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.programID, m.opts = programID, opts
+
+	return append(rpc.GetProgramAccountsResult{}, m.accounts...), nil
+}
+
+func (m *mockedProgramAccountsReader) setAccounts(accounts map[ag_solana.PublicKey][]byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.accounts = nil
+	for address, data := range accounts {
+		m.accounts = append(m.accounts, &rpc.KeyedAccount{
+			Pubkey:  address,
+			Account: &rpc.Account{Data: rpc.DataBytesOrJSONFromBytes(data)},
+		})
+	}
+}
+
+func (m *mockedProgramAccountsReader) last() (ag_solana.PublicKey, *rpc.GetProgramAccountsOpts) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.programID, m.opts
+}
@@ -0,0 +1,113 @@
+package chainreader
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/go-viper/mapstructure/v2"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+// RoundDataReader reads the rounds stored in OCR2 transmissions accounts and is required for
+// transmissions procedures. This is likely solana.RoundDataReader wrapping a solana client.
+type RoundDataReader interface {
+	GetRoundData(ctx context.Context, account solana.PublicKey, roundID uint32, commitment rpc.CommitmentType) (RoundData, error)
+	GetTransmissionsRange(ctx context.Context, account solana.PublicKey, fromRoundID, toRoundID uint32, commitment rpc.CommitmentType) ([]RoundData, error)
+}
+
+// RoundData is the result of a config.TransmissionsTypeRoundData read and the item of a
+// config.TransmissionsTypeRange read.
+type RoundData struct {
+	RoundID   uint32
+	Answer    *big.Int
+	Timestamp uint32
+	Slot      uint64
+}
+
+// RoundDataParams are the params of a config.TransmissionsTypeRoundData read.
+type RoundDataParams struct {
+	RoundID uint32
+}
+
+// TransmissionsRangeParams are the params of a config.TransmissionsTypeRange read.
+type TransmissionsRangeParams struct {
+	FromRoundID uint32
+	ToRoundID   uint32
+}
+
+// transmissionsReadBinding reads rounds of the bound transmissions account.
+type transmissionsReadBinding struct {
+	transmissions config.TransmissionsType
+	reader        RoundDataReader
+}
+
+func newTransmissionsReadBinding(transmissions config.TransmissionsType, reader RoundDataReader) (*transmissionsReadBinding, error) {
+	switch transmissions {
+	case config.TransmissionsTypeRoundData, config.TransmissionsTypeRange:
+		return &transmissionsReadBinding{transmissions: transmissions, reader: reader}, nil
+	default:
+		return nil, fmt.Errorf("%w: unrecognized transmissions type: %s", types.ErrInvalidConfig, transmissions)
+	}
+}
+
+var _ readBinding = &transmissionsReadBinding{}
+
+// PreLoad is a no-op because rounds are read with a single request.
+func (b *transmissionsReadBinding) PreLoad(_ context.Context, _ string, _ primitives.ConfidenceLevel, _ *loadedResult) {
+}
+
+func (b *transmissionsReadBinding) GetLatestValue(ctx context.Context, address string, confidence primitives.ConfidenceLevel, params, outVal any, _ *loadedResult) error {
+	account, err := solana.PublicKeyFromBase58(address)
+	if err != nil {
+		return err
+	}
+
+	commitment, err := commitmentForConfidence(confidence, "")
+	if err != nil {
+		return err
+	}
+
+	if params == nil {
+		return fmt.Errorf("%w: params are required to read %s transmissions", types.ErrInvalidType, b.transmissions)
+	}
+
+	var result any
+
+	switch b.transmissions {
+	case config.TransmissionsTypeRoundData:
+		var roundParams RoundDataParams
+		if err = mapstructure.Decode(params, &roundParams); err != nil {
+			return fmt.Errorf("%w: invalid round data params: %s", types.ErrInvalidType, err)
+		}
+
+		if result, err = b.reader.GetRoundData(ctx, account, roundParams.RoundID, commitment); err != nil {
+			return fmt.Errorf("%w: failed to get round data", err)
+		}
+	case config.TransmissionsTypeRange:
+		var rangeParams TransmissionsRangeParams
+		if err = mapstructure.Decode(params, &rangeParams); err != nil {
+			return fmt.Errorf("%w: invalid transmissions range params: %s", types.ErrInvalidType, err)
+		}
+
+		if result, err = b.reader.GetTransmissionsRange(ctx, account, rangeParams.FromRoundID, rangeParams.ToRoundID, commitment); err != nil {
+			return fmt.Errorf("%w: failed to get transmissions range", err)
+		}
+	}
+
+	return mapstructure.Decode(result, outVal)
+}
+
+func (b *transmissionsReadBinding) CreateType(_ bool) (any, error) {
+	if b.transmissions == config.TransmissionsTypeRange {
+		return &[]RoundData{}, nil
+	}
+
+	return &RoundData{}, nil
+}
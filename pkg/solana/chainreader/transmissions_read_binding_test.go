@@ -0,0 +1,129 @@
+package chainreader_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	ag_solana "github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/chainreader"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+func TestSolanaChainReaderService_TransmissionsProcedures(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+	transmissions := ag_solana.NewWallet().PublicKey()
+
+	transmissionsMethod := func(transmissions config.TransmissionsType) config.ChainDataReader {
+		return config.ChainDataReader{Procedures: []config.ChainReaderProcedure{{Transmissions: transmissions}}}
+	}
+
+	conf := config.ChainReader{Namespaces: map[string]config.ChainReaderMethods{
+		Namespace: {Methods: map[string]config.ChainDataReader{
+			"RoundData":          transmissionsMethod(config.TransmissionsTypeRoundData),
+			"TransmissionsRange": transmissionsMethod(config.TransmissionsTypeRange),
+		}},
+	}}
+
+	client := &mockedRoundDataReader{mockedRPCClient: new(mockedRPCClient), account: transmissions, latest: 5}
+
+	svc, err := chainreader.NewChainReaderService(logger.Test(t), client, conf)
+	require.NoError(t, err)
+	require.NoError(t, svc.Start(ctx))
+
+	t.Cleanup(func() {
+		require.NoError(t, svc.Close())
+	})
+
+	addresses, err := json.Marshal(map[string][]string{
+		"RoundData":          {transmissions.String()},
+		"TransmissionsRange": {transmissions.String()},
+	})
+	require.NoError(t, err)
+
+	binding := types.BoundContract{Name: Namespace, Address: base64.StdEncoding.EncodeToString(addresses)}
+	require.NoError(t, svc.Bind(ctx, []types.BoundContract{binding}))
+
+	var round chainreader.RoundData
+	require.NoError(t, svc.GetLatestValue(ctx, binding.ReadIdentifier("RoundData"), primitives.Finalized, chainreader.RoundDataParams{RoundID: 3}, &round))
+	assert.Equal(t, testRoundData(3), round)
+	assert.Equal(t, rpc.CommitmentFinalized, client.commitment)
+
+	var rounds []chainreader.RoundData
+	require.NoError(t, svc.GetLatestValue(ctx, binding.ReadIdentifier("TransmissionsRange"), primitives.Unconfirmed, map[string]any{"FromRoundID": 2, "ToRoundID": 4}, &rounds))
+	assert.Equal(t, []chainreader.RoundData{testRoundData(2), testRoundData(3), testRoundData(4)}, rounds)
+	assert.Equal(t, rpc.CommitmentConfirmed, client.commitment)
+
+	var value values.Value
+	require.NoError(t, svc.GetLatestValue(ctx, binding.ReadIdentifier("RoundData"), primitives.Unconfirmed, map[string]any{"RoundID": 5}, &value))
+
+	var fromValue chainreader.RoundData
+	require.NoError(t, value.UnwrapTo(&fromValue))
+	assert.Equal(t, testRoundData(5), fromValue)
+
+	require.ErrorContains(t, svc.GetLatestValue(ctx, binding.ReadIdentifier("RoundData"), primitives.Unconfirmed, map[string]any{"RoundID": 6}, &round), "round 6 not found")
+	require.ErrorIs(t, svc.GetLatestValue(ctx, binding.ReadIdentifier("RoundData"), primitives.Unconfirmed, nil, &round), types.ErrInvalidType)
+	require.ErrorIs(t, svc.GetLatestValue(ctx, binding.ReadIdentifier("RoundData"), primitives.Unconfirmed, map[string]any{"RoundID": "latest"}, &round), types.ErrInvalidType)
+
+	t.Run("invalid config", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := chainreader.NewChainReaderService(logger.Test(t), client, config.ChainReader{Namespaces: map[string]config.ChainReaderMethods{
+			Namespace: {Methods: map[string]config.ChainDataReader{"Unknown": transmissionsMethod("unknown")}},
+		}})
+		require.ErrorIs(t, err, types.ErrInvalidConfig)
+
+		_, err = chainreader.NewChainReaderService(logger.Test(t), new(mockedRPCClient), conf)
+		require.ErrorIs(t, err, types.ErrInvalidConfig)
+	})
+}
+
+func testRoundData(roundID uint32) chainreader.RoundData {
+	return chainreader.RoundData{RoundID: roundID, Answer: big.NewInt(int64(roundID) * 100), Timestamp: roundID, Slot: uint64(roundID) + 10}
+}
+
+type mockedRoundDataReader struct {
+	*mockedRPCClient
+
+	account    ag_solana.PublicKey
+	latest     uint32
+	commitment rpc.CommitmentType
+}
+
+func (m *mockedRoundDataReader) GetRoundData(_ context.Context, account ag_solana.PublicKey, roundID uint32, commitment rpc.CommitmentType) (chainreader.RoundData, error) {
+	m.commitment = commitment
+	if account != m.account || roundID == 0 || roundID > m.latest {
+		return chainreader.RoundData{}, fmt.Errorf("round %d not found", roundID)
+	}
+
+	return testRoundData(roundID), nil
+}
+
+func (m *mockedRoundDataReader) GetTransmissionsRange(_ context.Context, account ag_solana.PublicKey, fromRoundID, toRoundID uint32, commitment rpc.CommitmentType) ([]chainreader.RoundData, error) {
+	m.commitment = commitment
+	if account != m.account {
+		return nil, fmt.Errorf("unknown account %s", account)
+	}
+
+	var rounds []chainreader.RoundData
+	for roundID := max(fromRoundID, 1); roundID <= min(toRoundID, m.latest); roundID++ {
+		rounds = append(rounds, testRoundData(roundID))
+	}
+
+	return rounds, nil
+}
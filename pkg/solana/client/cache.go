@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -18,6 +19,23 @@ import (
 
 type CacheGetter[R any] func(ctx context.Context) (res R, slot uint64, err error)
 
+type minContextSlotKey struct{}
+
+// WithMinContextSlot sets the minimum slot at which getters should read, so that a lagging RPC
+// node fails the request instead of returning older data.
+func WithMinContextSlot(ctx context.Context, slot uint64) context.Context {
+	return context.WithValue(ctx, minContextSlotKey{}, slot)
+}
+
+// MinContextSlot returns the minimum slot set by WithMinContextSlot or nil.
+func MinContextSlot(ctx context.Context) *uint64 {
+	slot, ok := ctx.Value(minContextSlotKey{}).(uint64)
+	if !ok {
+		return nil
+	}
+	return &slot
+}
+
 // Cache is a generic implementation for caching data from the chain
 type Cache[R any] struct {
 	services.StateMachine
@@ -30,18 +48,31 @@ type Cache[R any] struct {
 	// stored answer
 	resLock sync.RWMutex
 	res     R
+	resSlot uint64
 	resTime time.Time
 
+	// change notifications
+	listeners []changeListener[R]
+
 	// dependencies
 	getter CacheGetter[R]
 	cfg    config.Config
 	lggr   logger.Logger
 
+	// subscription, replaces polling if set
+	subscriptions *AccountSubscriptions
+	decode        func([]byte) (R, error)
+
 	// polling
 	done   chan struct{}
 	stopCh services.StopChan
 }
 
+type changeListener[R any] struct {
+	changed func(prev, next R) bool
+	notify  chan struct{}
+}
+
 func NewCache[R any](metricName string, account solana.PublicKey, chainID string, cfg config.Config, getFunc CacheGetter[R], lggr logger.Logger) *Cache[R] {
 	return &Cache[R]{
 		metricName: metricName,
@@ -53,6 +84,28 @@ func NewCache[R any](metricName string, account solana.PublicKey, chainID string
 	}
 }
 
+// NewSubscribedCache creates a cache that is updated by an account subscription instead of polling.
+func NewSubscribedCache[R any](metricName string, account solana.PublicKey, chainID string, cfg config.Config, subscriptions *AccountSubscriptions, decode func([]byte) (R, error), lggr logger.Logger) *Cache[R] {
+	getter := func(ctx context.Context) (R, uint64, error) {
+		var res R
+
+		update, err := subscriptions.Fetch(ctx, account, cfg.Commitment())
+		if err != nil {
+			return res, 0, err
+		}
+
+		res, err = decode(update.Data)
+
+		return res, update.Slot, err
+	}
+
+	cache := NewCache(metricName, account, chainID, cfg, getter, lggr)
+	cache.subscriptions = subscriptions
+	cache.decode = decode
+
+	return cache
+}
+
 func (c *Cache[R]) Name() string {
 	return c.lggr.Name()
 }
@@ -69,6 +122,15 @@ func (c *Cache[R]) Start(ctx context.Context) error {
 		if err != nil {
 			c.lggr.Warnf("error in initial fetch %s", err)
 		}
+		if c.subscriptions != nil {
+			listener, err := c.subscriptions.Subscribe(c.Account, c.cfg.Commitment())
+			if err != nil {
+				close(c.done)
+				return err
+			}
+			go c.Listen(listener)
+			return nil
+		}
 		go c.Poll()
 		return nil
 	})
@@ -107,8 +169,50 @@ func (c *Cache[R]) Poll() {
 	}
 }
 
+// Listen stores the updates of an account subscription. Accounts are only sent when they change, so
+// the account is fetched from the RPC if no update was stored for a poll period. The result goes stale
+// if neither the subscription nor the RPC delivers data.
+func (c *Cache[R]) Listen(listener *AccountListener) {
+	defer close(c.done)
+	defer listener.Close()
+	ctx, cancel := c.stopCh.NewCtx()
+	defer cancel()
+	c.lggr.Debugf("Starting subscription: %s", c.Account)
+	tick := time.After(c.cfg.OCR2CachePollPeriod())
+	for {
+		select {
+		case <-ctx.Done():
+			c.lggr.Debugf("Stopping subscription: %s", c.Account)
+			return
+		case update := <-listener.Updates():
+			res, err := c.decode(update.Data)
+			if err != nil {
+				c.lggr.Errorf("error in Listen.decode %s", err)
+				continue
+			}
+			if !c.store(res, update.Slot, time.Now()) {
+				c.lggr.Debugf("ignoring update for account %s from older slot %d", c.Account, update.Slot)
+			}
+		case <-tick:
+			if time.Since(c.Timestamp()) >= c.cfg.OCR2CachePollPeriod() {
+				if err := c.Fetch(ctx); err != nil {
+					c.lggr.Errorf("error in Listen.fetch %s", err)
+				}
+			}
+			tick = time.After(utils.WithJitter(c.cfg.OCR2CachePollPeriod()))
+		}
+	}
+}
+
 // Read reads the latest result from memory with mutex and errors if timeout is exceeded
 func (c *Cache[R]) Read() (R, error) {
+	res, _, err := c.ReadWithSlot()
+	return res, err
+}
+
+// ReadWithSlot reads the latest result and the slot it was read at. Results never go back to an
+// older slot.
+func (c *Cache[R]) ReadWithSlot() (R, uint64, error) {
 	c.resLock.RLock()
 	defer c.resLock.RUnlock()
 
@@ -117,27 +221,74 @@ func (c *Cache[R]) Read() (R, error) {
 	if time.Since(c.resTime) > c.cfg.OCR2CacheTTL() {
 		err = errors.New("error in Read: stale data, polling is likely experiencing errors")
 	}
-	return c.res, err
+	return c.res, c.resSlot, err
+}
+
+// Slot returns the slot of the latest result or 0 if there is none.
+func (c *Cache[R]) Slot() uint64 {
+	c.resLock.RLock()
+	defer c.resLock.RUnlock()
+	return c.resSlot
 }
 
 func (c *Cache[R]) Timestamp() time.Time {
+	c.resLock.RLock()
+	defer c.resLock.RUnlock()
 	return c.resTime
 }
 
 func (c *Cache[R]) Fetch(ctx context.Context) error {
 	c.lggr.Debugf("fetch for account: %s", c.Account)
-	res, _, err := c.getter(ctx)
+	if slot := c.Slot(); slot > 0 {
+		ctx = WithMinContextSlot(ctx, slot)
+	}
+	res, slot, err := c.getter(ctx)
 	if err != nil {
 		return err
 	}
-	c.lggr.Debugf("latest fetched for account: %s, result: %v", c.Account, res)
+	c.lggr.Debugf("latest fetched for account: %s, slot: %d, result: %v", c.Account, slot, res)
 
-	timestamp := time.Now()
-	monitor.SetCacheTimestamp(timestamp, c.metricName, c.ChainID, c.Account.String())
+	if !c.store(res, slot, time.Now()) {
+		return fmt.Errorf("fetched result for account %s from slot %d is older than the cached slot %d", c.Account, slot, c.Slot())
+	}
+	return nil
+}
+
+// Store stores a result of the account read by another cache, e.g. together with other accounts. It
+// returns false if the result is from an older slot than the stored one.
+func (c *Cache[R]) Store(res R, slot uint64) bool {
+	return c.store(res, slot, time.Now())
+}
+
+// OnChange returns a channel that is signalled whenever a stored result is changed compared to the
+// previous one. Signals are coalesced, so a slow reader only receives one for several changes.
+func (c *Cache[R]) OnChange(changed func(prev, next R) bool) <-chan struct{} {
+	c.resLock.Lock()
+	defer c.resLock.Unlock()
+	notify := make(chan struct{}, 1)
+	c.listeners = append(c.listeners, changeListener[R]{changed: changed, notify: notify})
+	return notify
+}
+
+// store writes the result unless it is from an older slot than the stored one
+func (c *Cache[R]) store(res R, slot uint64, timestamp time.Time) bool {
 	// acquire lock and write to state
 	c.resLock.Lock()
 	defer c.resLock.Unlock()
+	if slot < c.resSlot {
+		return false
+	}
+	monitor.SetCacheTimestamp(timestamp, c.metricName, c.ChainID, c.Account.String())
+	for _, listener := range c.listeners {
+		if listener.changed(c.res, res) {
+			select {
+			case listener.notify <- struct{}{}:
+			default:
+			}
+		}
+	}
 	c.res = res
+	c.resSlot = slot
 	c.resTime = timestamp
-	return nil
+	return true
 }
@@ -30,12 +30,15 @@ type ReaderWriter interface {
 
 type Reader interface {
 	AccountReader
+	MultipleAccountsReader
 	Balance(ctx context.Context, addr solana.PublicKey) (uint64, error)
 	SlotHeight(ctx context.Context) (uint64, error)
 	LatestBlockhash(ctx context.Context) (*rpc.GetLatestBlockhashResult, error)
 	ChainID(ctx context.Context) (mn.StringID, error)
 	GetFeeForMessage(ctx context.Context, msg string) (uint64, error)
 	GetLatestBlock(ctx context.Context) (*rpc.GetBlockResult, error)
+	GetSignaturesForAddressWithOpts(ctx context.Context, addr solana.PublicKey, opts *rpc.GetSignaturesForAddressOpts) ([]*rpc.TransactionSignature, error)
+	GetTransaction(ctx context.Context, txSig solana.Signature, opts *rpc.GetTransactionOpts) (*rpc.GetTransactionResult, error)
 }
 
 // AccountReader is an interface that allows users to pass either the solana rpc client or the relay client
@@ -43,6 +46,11 @@ type AccountReader interface {
 	GetAccountInfoWithOpts(ctx context.Context, addr solana.PublicKey, opts *rpc.GetAccountInfoOpts) (*rpc.GetAccountInfoResult, error)
 }
 
+// MultipleAccountsReader reads several accounts at the same slot
+type MultipleAccountsReader interface {
+	GetMultipleAccountsWithOpts(ctx context.Context, accounts []solana.PublicKey, opts *rpc.GetMultipleAccountsOpts) (*rpc.GetMultipleAccountsResult, error)
+}
+
 type Writer interface {
 	SendTx(ctx context.Context, tx *solana.Transaction) (solana.Signature, error)
 	SimulateTx(ctx context.Context, tx *solana.Transaction, opts *rpc.SimulateTransactionOpts) (*rpc.SimulateTransactionResult, error)
@@ -125,8 +133,54 @@ func (c *Client) GetAccountInfoWithOpts(ctx context.Context, addr solana.PublicK
 
 	ctx, cancel := context.WithTimeout(ctx, c.contextDuration)
 	defer cancel()
-	opts.Commitment = c.commitment // overrides passed in value - use defined client commitment type
-	return c.rpc.GetAccountInfoWithOpts(ctx, addr, opts)
+
+	// use the defined client commitment type only if the caller did not request one
+	withCommitment := rpc.GetAccountInfoOpts{}
+	if opts != nil {
+		withCommitment = *opts
+	}
+	if withCommitment.Commitment == "" {
+		withCommitment.Commitment = c.commitment
+	}
+	return c.rpc.GetAccountInfoWithOpts(ctx, addr, &withCommitment)
+}
+
+func (c *Client) GetMultipleAccountsWithOpts(ctx context.Context, accounts []solana.PublicKey, opts *rpc.GetMultipleAccountsOpts) (*rpc.GetMultipleAccountsResult, error) {
+	done := c.latency("multiple_accounts")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, c.contextDuration)
+	defer cancel()
+
+	// use the defined client commitment type only if the caller did not request one
+	withCommitment := rpc.GetMultipleAccountsOpts{}
+	if opts != nil {
+		withCommitment = *opts
+	}
+	if withCommitment.Commitment == "" {
+		withCommitment.Commitment = c.commitment
+	}
+	return c.rpc.GetMultipleAccountsWithOpts(ctx, accounts, &withCommitment)
+}
+
+func (c *Client) GetSignaturesForAddressWithOpts(ctx context.Context, addr solana.PublicKey, opts *rpc.GetSignaturesForAddressOpts) ([]*rpc.TransactionSignature, error) {
+	done := c.latency("signatures_for_address")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, c.contextDuration)
+	defer cancel()
+
+	return c.rpc.GetSignaturesForAddressWithOpts(ctx, addr, opts)
+}
+
+func (c *Client) GetTransaction(ctx context.Context, txSig solana.Signature, opts *rpc.GetTransactionOpts) (*rpc.GetTransactionResult, error) {
+	done := c.latency("transaction")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, c.contextDuration)
+	defer cancel()
+
+	return c.rpc.GetTransaction(ctx, txSig, opts)
 }
 
 func (c *Client) LatestBlockhash(ctx context.Context) (*rpc.GetLatestBlockhashResult, error) {
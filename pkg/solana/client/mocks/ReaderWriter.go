@@ -161,6 +161,96 @@ func (_m *ReaderWriter) GetLatestBlock(ctx context.Context) (*rpc.GetBlockResult
 	return r0, r1
 }
 
+// GetMultipleAccountsWithOpts provides a mock function with given fields: ctx, accounts, opts
+func (_m *ReaderWriter) GetMultipleAccountsWithOpts(ctx context.Context, accounts []solana.PublicKey, opts *rpc.GetMultipleAccountsOpts) (*rpc.GetMultipleAccountsResult, error) {
+	ret := _m.Called(ctx, accounts, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMultipleAccountsWithOpts")
+	}
+
+	var r0 *rpc.GetMultipleAccountsResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []solana.PublicKey, *rpc.GetMultipleAccountsOpts) (*rpc.GetMultipleAccountsResult, error)); ok {
+		return rf(ctx, accounts, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []solana.PublicKey, *rpc.GetMultipleAccountsOpts) *rpc.GetMultipleAccountsResult); ok {
+		r0 = rf(ctx, accounts, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rpc.GetMultipleAccountsResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []solana.PublicKey, *rpc.GetMultipleAccountsOpts) error); ok {
+		r1 = rf(ctx, accounts, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSignaturesForAddressWithOpts provides a mock function with given fields: ctx, addr, opts
+func (_m *ReaderWriter) GetSignaturesForAddressWithOpts(ctx context.Context, addr solana.PublicKey, opts *rpc.GetSignaturesForAddressOpts) ([]*rpc.TransactionSignature, error) {
+	ret := _m.Called(ctx, addr, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSignaturesForAddressWithOpts")
+	}
+
+	var r0 []*rpc.TransactionSignature
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, solana.PublicKey, *rpc.GetSignaturesForAddressOpts) ([]*rpc.TransactionSignature, error)); ok {
+		return rf(ctx, addr, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, solana.PublicKey, *rpc.GetSignaturesForAddressOpts) []*rpc.TransactionSignature); ok {
+		r0 = rf(ctx, addr, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*rpc.TransactionSignature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, solana.PublicKey, *rpc.GetSignaturesForAddressOpts) error); ok {
+		r1 = rf(ctx, addr, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransaction provides a mock function with given fields: ctx, txSig, opts
+func (_m *ReaderWriter) GetTransaction(ctx context.Context, txSig solana.Signature, opts *rpc.GetTransactionOpts) (*rpc.GetTransactionResult, error) {
+	ret := _m.Called(ctx, txSig, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTransaction")
+	}
+
+	var r0 *rpc.GetTransactionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, solana.Signature, *rpc.GetTransactionOpts) (*rpc.GetTransactionResult, error)); ok {
+		return rf(ctx, txSig, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, solana.Signature, *rpc.GetTransactionOpts) *rpc.GetTransactionResult); ok {
+		r0 = rf(ctx, txSig, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rpc.GetTransactionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, solana.Signature, *rpc.GetTransactionOpts) error); ok {
+		r1 = rf(ctx, txSig, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LatestBlockhash provides a mock function with given fields: ctx
 func (_m *ReaderWriter) LatestBlockhash(ctx context.Context) (*rpc.GetLatestBlockhashResult, error) {
 	ret := _m.Called(ctx)
@@ -0,0 +1,550 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/gagliardetto/solana-go/rpc/ws"
+	"github.com/jpillora/backoff"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+// AccountUpdate is the data of an account as observed at a slot.
+type AccountUpdate struct {
+	Slot uint64
+	Data []byte
+}
+
+// accountStream is a single account subscription that is closed by Unsubscribe.
+type accountStream interface {
+	Recv() (AccountUpdate, error)
+	Unsubscribe()
+}
+
+// accountStreamer opens account subscriptions.
+type accountStreamer interface {
+	subscribe(account solana.PublicKey, commitment rpc.CommitmentType) (accountStream, error)
+	close()
+}
+
+const (
+	// readIdleTimeout is how long a subscription opened by ReadAll is kept without reads
+	readIdleTimeout = 10 * time.Minute
+	// maxReadSubscriptions bounds the subscriptions opened by ReadAll, further reads use the RPC
+	maxReadSubscriptions = 100
+)
+
+type subscriptionKey struct {
+	account    solana.PublicKey
+	commitment rpc.CommitmentType
+}
+
+// AccountSubscriptions shares websocket account subscriptions between caches and chain reader
+// bindings. Each account and commitment has a single subscription that is fanned out to all
+// listeners. While the websocket is disconnected, the account is polled instead.
+type AccountSubscriptions struct {
+	services.StateMachine
+
+	reader   AccountReader
+	streamer accountStreamer
+	cfg      config.Config
+	lggr     logger.Logger
+
+	lock          sync.Mutex
+	subscriptions map[subscriptionKey]*accountSubscription
+	listenerID    int
+
+	// listeners that keep subscriptions open for ReadAll, locked before lock
+	readsLock       sync.Mutex
+	reads           map[subscriptionKey]*readSubscription
+	readIdleTimeout time.Duration
+	maxReads        int
+
+	wg     sync.WaitGroup
+	stopCh services.StopChan
+}
+
+// NewAccountSubscriptions subscribes to accounts over the websocket endpoint and polls with the
+// reader when the websocket is not available.
+func NewAccountSubscriptions(wsURL string, reader AccountReader, cfg config.Config, lggr logger.Logger) *AccountSubscriptions {
+	return newAccountSubscriptions(&wsStreamer{url: wsURL}, reader, cfg, lggr)
+}
+
+func newAccountSubscriptions(streamer accountStreamer, reader AccountReader, cfg config.Config, lggr logger.Logger) *AccountSubscriptions {
+	return &AccountSubscriptions{
+		reader:          reader,
+		streamer:        streamer,
+		cfg:             cfg,
+		lggr:            logger.Named(lggr, "AccountSubscriptions"),
+		subscriptions:   make(map[subscriptionKey]*accountSubscription),
+		reads:           make(map[subscriptionKey]*readSubscription),
+		readIdleTimeout: readIdleTimeout,
+		maxReads:        maxReadSubscriptions,
+		stopCh:          make(chan struct{}),
+	}
+}
+
+func (s *AccountSubscriptions) Name() string {
+	return s.lggr.Name()
+}
+
+func (s *AccountSubscriptions) Start(_ context.Context) error {
+	return s.StartOnce("AccountSubscriptions", func() error {
+		s.wg.Add(1)
+		go s.releaseIdleReads()
+
+		return nil
+	})
+}
+
+func (s *AccountSubscriptions) Close() error {
+	return s.StopOnce("AccountSubscriptions", func() error {
+		close(s.stopCh)
+		s.wg.Wait()
+		s.streamer.close()
+
+		return nil
+	})
+}
+
+func (s *AccountSubscriptions) HealthReport() map[string]error {
+	return map[string]error{s.Name(): s.Healthy()}
+}
+
+// Subscribe returns a listener for updates of the account. The subscription is shared with all
+// other listeners of the account and commitment and ends when the last listener is closed.
+func (s *AccountSubscriptions) Subscribe(account solana.PublicKey, commitment rpc.CommitmentType) (*AccountListener, error) {
+	if err := s.Ready(); err != nil {
+		return nil, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := subscriptionKey{account: account, commitment: commitment}
+
+	sub, exists := s.subscriptions[key]
+	if !exists {
+		ctx, cancel := s.stopCh.NewCtx()
+		sub = &accountSubscription{listeners: make(map[int]chan AccountUpdate), cancel: cancel}
+		s.subscriptions[key] = sub
+
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.run(ctx, key, sub)
+		}()
+	}
+
+	s.listenerID++
+	updates := make(chan AccountUpdate, 1)
+	sub.addListener(s.listenerID, updates)
+
+	return &AccountListener{id: s.listenerID, key: key, sub: sub, updates: updates, subscriptions: s}, nil
+}
+
+// ReadAll implements the chain reader BinaryDataReader and reads full accounts from a subscription
+// that is kept until the account is not read for a while. Reads with a data slice, reads before the
+// first update and reads beyond the subscription limit are sent to the RPC.
+func (s *AccountSubscriptions) ReadAll(ctx context.Context, account solana.PublicKey, opts *rpc.GetAccountInfoOpts) ([]byte, error) {
+	var commitment rpc.CommitmentType
+	if opts != nil {
+		commitment = opts.Commitment
+	}
+
+	if opts == nil || opts.DataSlice == nil {
+		if update, ok := s.latest(account, commitment); ok {
+			return update.Data, nil
+		}
+	}
+
+	res, err := s.reader.GetAccountInfoWithOpts(ctx, account, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil || res.Value == nil || res.Value.Data == nil {
+		return nil, fmt.Errorf("nil pointer returned for account %s", account)
+	}
+
+	return res.Value.Data.GetBinary(), nil
+}
+
+// Fetch reads the full account from the RPC with the commitment and the minimum slot of the
+// context, if set.
+func (s *AccountSubscriptions) Fetch(ctx context.Context, account solana.PublicKey, commitment rpc.CommitmentType) (AccountUpdate, error) {
+	res, err := s.reader.GetAccountInfoWithOpts(ctx, account, &rpc.GetAccountInfoOpts{
+		Commitment:     commitment,
+		Encoding:       solana.EncodingBase64,
+		MinContextSlot: MinContextSlot(ctx),
+	})
+	if err != nil {
+		return AccountUpdate{}, fmt.Errorf("failed to fetch account '%s': %w", account, err)
+	}
+
+	if res == nil || res.Value == nil || res.Value.Data == nil {
+		return AccountUpdate{}, fmt.Errorf("nil pointer returned for account '%s'", account)
+	}
+
+	return AccountUpdate{Slot: res.Context.Slot, Data: res.Value.Data.GetBinary()}, nil
+}
+
+// latest returns the latest update of a subscription and subscribes to the account if needed.
+func (s *AccountSubscriptions) latest(account solana.PublicKey, commitment rpc.CommitmentType) (AccountUpdate, bool) {
+	if s.Ready() != nil {
+		return AccountUpdate{}, false
+	}
+
+	s.readsLock.Lock()
+	defer s.readsLock.Unlock()
+
+	key := subscriptionKey{account: account, commitment: commitment}
+
+	read, exists := s.reads[key]
+	if !exists {
+		if len(s.reads) >= s.maxReads {
+			return AccountUpdate{}, false
+		}
+
+		listener, err := s.Subscribe(account, commitment)
+		if err != nil {
+			return AccountUpdate{}, false
+		}
+
+		read = &readSubscription{listener: listener}
+		s.reads[key] = read
+	}
+
+	read.lastRead = time.Now()
+
+	return read.listener.Latest()
+}
+
+// releaseIdleReads closes the subscriptions opened by ReadAll that were not read for readIdleTimeout.
+func (s *AccountSubscriptions) releaseIdleReads() {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(s.readIdleTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopCh:
+			return
+		case <-ticker.C:
+			s.readsLock.Lock()
+			for key, read := range s.reads {
+				if time.Since(read.lastRead) >= s.readIdleTimeout {
+					read.listener.Close()
+					delete(s.reads, key)
+				}
+			}
+			s.readsLock.Unlock()
+		}
+	}
+}
+
+func (s *AccountSubscriptions) unsubscribe(listener *AccountListener) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if sub, exists := s.subscriptions[listener.key]; exists && sub.removeListener(listener.id) == 0 {
+		sub.cancel()
+		delete(s.subscriptions, listener.key)
+	}
+}
+
+// run streams updates of the account and polls while the stream is not available.
+func (s *AccountSubscriptions) run(ctx context.Context, key subscriptionKey, sub *accountSubscription) {
+	lggr := logger.With(s.lggr, "account", key.account, "commitment", key.commitment)
+	redial := &backoff.Backoff{Min: s.cfg.OCR2CachePollPeriod(), Max: time.Minute, Jitter: true}
+
+	for {
+		err := s.stream(ctx, key, sub, redial)
+		if ctx.Err() != nil {
+			return
+		}
+
+		lggr.Warnw("Account subscription failed, polling until reconnect", "err", err)
+		s.poll(ctx, key, sub, time.After(redial.Duration()))
+
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func (s *AccountSubscriptions) stream(ctx context.Context, key subscriptionKey, sub *accountSubscription, redial *backoff.Backoff) error {
+	stream, err := s.streamer.subscribe(key.account, key.commitment)
+	if err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	sub.setStreaming(true)
+	defer sub.setStreaming(false)
+
+	// Recv does not take a context, unsubscribing ends it
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+
+		stream.Unsubscribe()
+	}()
+
+	// notifications are only sent on changes, so the current data is fetched once subscribed
+	if update, err := s.Fetch(ctx, key.account, key.commitment); err == nil {
+		sub.publish(update)
+	}
+
+	redial.Reset()
+
+	for {
+		update, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		sub.publish(update)
+	}
+}
+
+func (s *AccountSubscriptions) poll(ctx context.Context, key subscriptionKey, sub *accountSubscription, until <-chan time.Time) {
+	tick := time.After(0)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-until:
+			return
+		case <-tick:
+			update, err := s.Fetch(ctx, key.account, key.commitment)
+			if err != nil {
+				s.lggr.Errorw("Failed to poll account", "account", key.account, "err", err)
+			} else {
+				sub.publish(update)
+			}
+
+			tick = time.After(utils.WithJitter(s.cfg.OCR2CachePollPeriod()))
+		}
+	}
+}
+
+// readSubscription keeps a subscription open for ReadAll.
+type readSubscription struct {
+	listener *AccountListener
+	lastRead time.Time
+}
+
+// AccountListener receives the updates of a shared account subscription.
+type AccountListener struct {
+	id            int
+	key           subscriptionKey
+	sub           *accountSubscription
+	updates       chan AccountUpdate
+	subscriptions *AccountSubscriptions
+	closeOnce     sync.Once
+}
+
+// Updates returns the channel of updates. Only the most recent update is buffered, so a slow
+// listener skips intermediate updates.
+func (l *AccountListener) Updates() <-chan AccountUpdate {
+	return l.updates
+}
+
+// Latest returns the most recent update of the subscription if there is one.
+func (l *AccountListener) Latest() (AccountUpdate, bool) {
+	return l.sub.latest()
+}
+
+// Streaming reports whether updates are currently pushed by the websocket.
+func (l *AccountListener) Streaming() bool {
+	return l.sub.isStreaming()
+}
+
+// Close removes the listener and ends the subscription if it was the last one.
+func (l *AccountListener) Close() {
+	l.closeOnce.Do(func() {
+		l.subscriptions.unsubscribe(l)
+	})
+}
+
+type accountSubscription struct {
+	cancel context.CancelFunc
+
+	lock      sync.RWMutex
+	update    AccountUpdate
+	received  bool
+	streaming bool
+	listeners map[int]chan AccountUpdate
+}
+
+func (s *accountSubscription) setStreaming(streaming bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.streaming = streaming
+}
+
+func (s *accountSubscription) isStreaming() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.streaming
+}
+
+func (s *accountSubscription) addListener(id int, updates chan AccountUpdate) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.listeners[id] = updates
+
+	if s.received {
+		updates <- s.update
+	}
+}
+
+func (s *accountSubscription) removeListener(id int) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.listeners, id)
+
+	return len(s.listeners)
+}
+
+func (s *accountSubscription) latest() (AccountUpdate, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.update, s.received
+}
+
+// publish stores the update and sends it to all listeners unless it is older than the latest one.
+func (s *accountSubscription) publish(update AccountUpdate) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.received && update.Slot < s.update.Slot {
+		return
+	}
+
+	s.update = update
+	s.received = true
+
+	for _, updates := range s.listeners {
+		// replace an update the listener has not received yet
+		select {
+		case <-updates:
+		default:
+		}
+
+		updates <- update
+	}
+}
+
+// wsStreamer shares a websocket connection between account subscriptions and reconnects after the
+// connection fails.
+type wsStreamer struct {
+	url string
+
+	lock   sync.Mutex
+	client *ws.Client
+}
+
+func (w *wsStreamer) subscribe(account solana.PublicKey, commitment rpc.CommitmentType) (accountStream, error) {
+	client, err := w.connect()
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := client.AccountSubscribeWithOpts(account, commitment, solana.EncodingBase64)
+	if err != nil {
+		w.reset(client)
+		return nil, err
+	}
+
+	return &wsAccountStream{sub: sub, client: client, streamer: w}, nil
+}
+
+func (w *wsStreamer) connect() (*ws.Client, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.client != nil {
+		return w.client, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	client, err := ws.Connect(ctx, w.url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to websocket: %w", err)
+	}
+
+	w.client = client
+
+	return client, nil
+}
+
+// reset closes the client if it is still the current one so that the next subscription reconnects.
+func (w *wsStreamer) reset(client *ws.Client) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.client == client {
+		w.client.Close()
+		w.client = nil
+	}
+}
+
+func (w *wsStreamer) close() {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.client != nil {
+		w.client.Close()
+		w.client = nil
+	}
+}
+
+type wsAccountStream struct {
+	sub      *ws.AccountSubscription
+	client   *ws.Client
+	streamer *wsStreamer
+}
+
+func (s *wsAccountStream) Recv() (AccountUpdate, error) {
+	res, err := s.sub.Recv()
+	if err != nil {
+		// subscriptions only fail together with the connection
+		s.streamer.reset(s.client)
+		return AccountUpdate{}, err
+	}
+
+	if res == nil || res.Value.Data == nil {
+		return AccountUpdate{}, errors.New("nil pointer in account notification")
+	}
+
+	return AccountUpdate{Slot: res.Context.Slot, Data: res.Value.Data.GetBinary()}, nil
+}
+
+func (s *wsAccountStream) Unsubscribe() {
+	s.sub.Unsubscribe()
+}
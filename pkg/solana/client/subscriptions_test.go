@@ -0,0 +1,364 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	relayconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+func TestAccountSubscriptions_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	account := solana.NewWallet().PublicKey()
+	streamer := newFakeStreamer()
+	reader := &fakeAccountReader{update: AccountUpdate{Slot: 10, Data: []byte{1}}}
+	subs := newTestAccountSubscriptions(t, streamer, reader)
+
+	first, err := subs.Subscribe(account, rpc.CommitmentConfirmed)
+	require.NoError(t, err)
+
+	second, err := subs.Subscribe(account, rpc.CommitmentConfirmed)
+	require.NoError(t, err)
+
+	// the current data is fetched once subscribed
+	assert.Equal(t, AccountUpdate{Slot: 10, Data: []byte{1}}, receive(t, first))
+	assert.Equal(t, AccountUpdate{Slot: 10, Data: []byte{1}}, receive(t, second))
+	assert.Equal(t, 1, streamer.subscriptions())
+
+	t.Run("fans out updates", func(t *testing.T) {
+		streamer.stream(t).send(AccountUpdate{Slot: 11, Data: []byte{2}})
+
+		assert.Equal(t, AccountUpdate{Slot: 11, Data: []byte{2}}, receive(t, first))
+		assert.Equal(t, AccountUpdate{Slot: 11, Data: []byte{2}}, receive(t, second))
+		assert.True(t, first.Streaming())
+	})
+
+	t.Run("ignores older slots", func(t *testing.T) {
+		streamer.stream(t).send(AccountUpdate{Slot: 9, Data: []byte{3}})
+		streamer.stream(t).send(AccountUpdate{Slot: 12, Data: []byte{4}})
+
+		assert.Equal(t, AccountUpdate{Slot: 12, Data: []byte{4}}, receive(t, first))
+
+		latest, ok := second.Latest()
+		require.True(t, ok)
+		assert.Equal(t, uint64(12), latest.Slot)
+	})
+
+	t.Run("unsubscribes after the last listener", func(t *testing.T) {
+		stream := streamer.stream(t)
+
+		first.Close()
+		assert.False(t, stream.isClosed())
+
+		second.Close()
+		tests.AssertEventually(t, stream.isClosed)
+	})
+}
+
+func TestAccountSubscriptions_PollsOnDisconnect(t *testing.T) {
+	t.Parallel()
+
+	account := solana.NewWallet().PublicKey()
+	streamer := newFakeStreamer()
+	reader := &fakeAccountReader{update: AccountUpdate{Slot: 10, Data: []byte{1}}}
+	subs := newTestAccountSubscriptions(t, streamer, reader)
+
+	listener, err := subs.Subscribe(account, rpc.CommitmentConfirmed)
+	require.NoError(t, err)
+	t.Cleanup(listener.Close)
+
+	assert.Equal(t, uint64(10), receive(t, listener).Slot)
+
+	streamer.setFailing(true)
+	streamer.stream(t).fail(errors.New("connection lost"))
+
+	reader.set(AccountUpdate{Slot: 20, Data: []byte{2}}, nil)
+	assert.Equal(t, AccountUpdate{Slot: 20, Data: []byte{2}}, receive(t, listener))
+	assert.False(t, listener.Streaming())
+
+	// reconnects once the websocket is available again
+	streamer.setFailing(false)
+	tests.AssertEventually(t, listener.Streaming)
+}
+
+func TestAccountSubscriptions_ReadAll(t *testing.T) {
+	t.Parallel()
+
+	account := solana.NewWallet().PublicKey()
+	streamer := newFakeStreamer()
+	reader := &fakeAccountReader{update: AccountUpdate{Slot: 10, Data: []byte{1}}}
+	subs := newTestAccountSubscriptions(t, streamer, reader)
+
+	opts := &rpc.GetAccountInfoOpts{Commitment: rpc.CommitmentFinalized, Encoding: solana.EncodingBase64}
+
+	data, err := subs.ReadAll(tests.Context(t), account, opts)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1}, data)
+
+	// later reads are served by the subscription
+	streamer.stream(t).send(AccountUpdate{Slot: 11, Data: []byte{2}})
+
+	tests.AssertEventually(t, func() bool {
+		data, err = subs.ReadAll(tests.Context(t), account, opts)
+		return err == nil && len(data) == 1 && data[0] == 2
+	})
+
+	// data slices are read from the RPC
+	reader.set(AccountUpdate{Slot: 12, Data: []byte{3}}, nil)
+	offset, length := uint64(0), uint64(1)
+
+	data, err = subs.ReadAll(tests.Context(t), account, &rpc.GetAccountInfoOpts{
+		Commitment: rpc.CommitmentFinalized,
+		DataSlice:  &rpc.DataSlice{Offset: &offset, Length: &length},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{3}, data)
+}
+
+func TestAccountSubscriptions_ReleasesReads(t *testing.T) {
+	t.Parallel()
+
+	streamer := newFakeStreamer()
+	reader := &fakeAccountReader{update: AccountUpdate{Slot: 10, Data: []byte{1}}}
+
+	cfg := config.NewDefault()
+	cfg.Chain.OCR2CachePollPeriod = relayconfig.MustNewDuration(10 * time.Millisecond)
+
+	subs := newAccountSubscriptions(streamer, reader, cfg, logger.Test(t))
+	subs.readIdleTimeout = 50 * time.Millisecond
+	subs.maxReads = 1
+	require.NoError(t, subs.Start(tests.Context(t)))
+	t.Cleanup(func() { require.NoError(t, subs.Close()) })
+
+	opts := &rpc.GetAccountInfoOpts{Commitment: rpc.CommitmentFinalized}
+
+	first := solana.NewWallet().PublicKey()
+	_, err := subs.ReadAll(tests.Context(t), first, opts)
+	require.NoError(t, err)
+	stream := streamer.stream(t)
+
+	// reads beyond the limit are sent to the RPC without subscribing
+	second := solana.NewWallet().PublicKey()
+	data, err := subs.ReadAll(tests.Context(t), second, opts)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1}, data)
+	assert.Equal(t, 1, streamer.subscriptions())
+
+	// the subscription is released once it is not read anymore
+	tests.AssertEventually(t, stream.isClosed)
+
+	_, err = subs.ReadAll(tests.Context(t), second, opts)
+	require.NoError(t, err)
+	tests.AssertEventually(t, func() bool { return streamer.subscriptions() == 2 })
+}
+
+func TestSubscribedCache(t *testing.T) {
+	t.Parallel()
+
+	account := solana.NewWallet().PublicKey()
+	streamer := newFakeStreamer()
+	reader := &fakeAccountReader{update: AccountUpdate{Slot: 10, Data: []byte{1}}}
+	subs := newTestAccountSubscriptions(t, streamer, reader)
+
+	decode := func(data []byte) (byte, error) {
+		if len(data) != 1 {
+			return 0, errors.New("invalid data")
+		}
+		return data[0], nil
+	}
+
+	cache := NewSubscribedCache("test", account, "localnet", subs.cfg, subs, decode, logger.Test(t))
+	require.NoError(t, cache.Start(tests.Context(t)))
+	t.Cleanup(func() { require.NoError(t, cache.Close()) })
+
+	res, err := cache.Read()
+	require.NoError(t, err)
+	assert.Equal(t, byte(1), res)
+
+	streamer.stream(t).send(AccountUpdate{Slot: 11, Data: []byte{2}})
+
+	tests.AssertEventually(t, func() bool {
+		res, err = cache.Read()
+		return err == nil && res == 2
+	})
+
+	// undecodable updates keep the previous result
+	streamer.stream(t).send(AccountUpdate{Slot: 12, Data: []byte{}})
+	streamer.stream(t).send(AccountUpdate{Slot: 13, Data: []byte{3}})
+
+	tests.AssertEventually(t, func() bool {
+		res, err = cache.Read()
+		return err == nil && res == 3
+	})
+
+	t.Run("silent subscription is verified with the RPC", func(t *testing.T) {
+		reader.set(AccountUpdate{Slot: 14, Data: []byte{4}}, nil)
+
+		tests.AssertEventually(t, func() bool {
+			res, err = cache.Read()
+			return err == nil && res == 4
+		})
+
+		// the stream stays connected but sends nothing, so the result goes stale once the RPC fails
+		reader.set(AccountUpdate{}, errors.New("rpc unavailable"))
+
+		tests.AssertEventually(t, func() bool {
+			_, err = cache.Read()
+			return err != nil
+		})
+		assert.False(t, streamer.stream(t).isClosed())
+	})
+}
+
+func newTestAccountSubscriptions(t *testing.T, streamer accountStreamer, reader AccountReader) *AccountSubscriptions {
+	cfg := config.NewDefault()
+	cfg.Chain.OCR2CachePollPeriod = relayconfig.MustNewDuration(10 * time.Millisecond)
+	cfg.Chain.OCR2CacheTTL = relayconfig.MustNewDuration(100 * time.Millisecond)
+
+	subs := newAccountSubscriptions(streamer, reader, cfg, logger.Test(t))
+	require.NoError(t, subs.Start(tests.Context(t)))
+	t.Cleanup(func() { require.NoError(t, subs.Close()) })
+
+	return subs
+}
+
+func receive(t *testing.T, listener *AccountListener) AccountUpdate {
+	t.Helper()
+
+	select {
+	case update := <-listener.Updates():
+		return update
+	case <-time.After(tests.WaitTimeout(t)):
+		require.FailNow(t, "timed out waiting for update")
+		return AccountUpdate{}
+	}
+}
+
+type fakeAccountReader struct {
+	lock   sync.Mutex
+	update AccountUpdate
+	err    error
+}
+
+func (r *fakeAccountReader) set(update AccountUpdate, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.update, r.err = update, err
+}
+
+func (r *fakeAccountReader) GetAccountInfoWithOpts(_ context.Context, _ solana.PublicKey, _ *rpc.GetAccountInfoOpts) (*rpc.GetAccountInfoResult, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return &rpc.GetAccountInfoResult{
+		RPCContext: rpc.RPCContext{Context: rpc.Context{Slot: r.update.Slot}},
+		Value:      &rpc.Account{Data: rpc.DataBytesOrJSONFromBytes(r.update.Data)},
+	}, nil
+}
+
+type fakeStreamer struct {
+	lock    sync.Mutex
+	failing bool
+	streams []*fakeStream
+}
+
+func newFakeStreamer() *fakeStreamer {
+	return &fakeStreamer{}
+}
+
+func (s *fakeStreamer) subscribe(_ solana.PublicKey, _ rpc.CommitmentType) (accountStream, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.failing {
+		return nil, errors.New("websocket unavailable")
+	}
+
+	stream := &fakeStream{updates: make(chan AccountUpdate, 10), errs: make(chan error, 1), closed: make(chan struct{})}
+	s.streams = append(s.streams, stream)
+
+	return stream, nil
+}
+
+func (s *fakeStreamer) close() {}
+
+func (s *fakeStreamer) setFailing(failing bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.failing = failing
+}
+
+func (s *fakeStreamer) subscriptions() int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return len(s.streams)
+}
+
+// stream returns the latest stream once it is subscribed.
+func (s *fakeStreamer) stream(t *testing.T) *fakeStream {
+	tests.AssertEventually(t, func() bool { return s.subscriptions() > 0 })
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.streams[len(s.streams)-1]
+}
+
+type fakeStream struct {
+	updates   chan AccountUpdate
+	errs      chan error
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (s *fakeStream) send(update AccountUpdate) {
+	s.updates <- update
+}
+
+func (s *fakeStream) fail(err error) {
+	s.errs <- err
+}
+
+func (s *fakeStream) isClosed() bool {
+	select {
+	case <-s.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *fakeStream) Recv() (AccountUpdate, error) {
+	select {
+	case update := <-s.updates:
+		return update, nil
+	case err := <-s.errs:
+		return AccountUpdate{}, err
+	case <-s.closed:
+		return AccountUpdate{}, errors.New("unsubscribed")
+	}
+}
+
+func (s *fakeStream) Unsubscribe() {
+	s.closeOnce.Do(func() { close(s.closed) })
+}
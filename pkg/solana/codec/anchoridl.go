@@ -8,12 +8,16 @@ in the `main` package.
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/gagliardetto/utilz"
 )
 
 // https://github.com/project-serum/anchor/blob/97e9e03fb041b8b888a9876a7c0676d9bb4736f3/ts/src/idl.ts
+//
+// IDLs in the Anchor 0.30+ format are detected by the `metadata.spec` field and are converted to this structure when
+// unmarshalled. See anchoridl_030.go.
 type IDL struct {
 	Version      string           `json:"version"`
 	Name         string           `json:"name"`
@@ -23,6 +27,9 @@ type IDL struct {
 	Events       []IdlEvent       `json:"events,omitempty"`
 	Errors       []IdlErrorCode   `json:"errors,omitempty"`
 	Constants    []IdlConstant    `json:"constants,omitempty"`
+
+	// Address is the program address and is only available in the Anchor 0.30+ format.
+	Address string `json:"address,omitempty"`
 }
 
 type IdlConstant struct {
@@ -46,6 +53,8 @@ func (named IdlTypeDefSlice) GetByName(name string) *IdlTypeDef {
 type IdlEvent struct {
 	Name   string          `json:"name"`
 	Fields []IdlEventField `json:"fields"`
+	// Discriminator is declared in the Anchor 0.30+ format and is empty for legacy IDLs.
+	Discriminator IdlDiscriminator `json:"discriminator,omitempty"`
 }
 
 type IdlEventField struct {
@@ -59,6 +68,9 @@ type IdlInstruction struct {
 	Docs     []string            `json:"docs"` // @custom
 	Accounts IdlAccountItemSlice `json:"accounts"`
 	Args     []IdlField          `json:"args"`
+	Returns  *IdlType            `json:"returns,omitempty"`
+	// Discriminator is declared in the Anchor 0.30+ format and is empty for legacy IDLs.
+	Discriminator IdlDiscriminator `json:"discriminator,omitempty"`
 }
 
 type IdlAccountItemSlice []IdlAccountItem
@@ -104,13 +116,13 @@ func (env *IdlAccountItem) UnmarshalJSON(data []byte) error {
 			if err := utilz.TranscodeJSON(temp, &env.IdlAccounts); err != nil {
 				return err
 			}
+
+			return nil
 		}
-		// Single account:
-		// TODO: check both isMut and isSigner
-		if _, ok := v["isMut"]; ok {
-			if err := utilz.TranscodeJSON(temp, &env.IdlAccount); err != nil {
-				return err
-			}
+
+		// Single account in either the legacy (isMut, isSigner) or the 0.30+ (writable, signer) format:
+		if err := utilz.TranscodeJSON(temp, &env.IdlAccount); err != nil {
+			return err
 		}
 	default:
 		return fmt.Errorf("Unknown kind: %s", spew.Sdump(temp))
@@ -125,6 +137,28 @@ type IdlAccount struct {
 	IsMut    bool     `json:"isMut"`
 	IsSigner bool     `json:"isSigner"`
 	Optional bool     `json:"optional"` // @custom
+	// Address is the fixed address of the account and is only available in the Anchor 0.30+ format.
+	Address string `json:"address,omitempty"`
+}
+
+func (acc *IdlAccount) UnmarshalJSON(data []byte) error {
+	type idlAccount IdlAccount
+
+	var raw struct {
+		idlAccount
+		Writable bool `json:"writable"`
+		Signer   bool `json:"signer"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*acc = IdlAccount(raw.idlAccount)
+	acc.IsMut = acc.IsMut || raw.Writable
+	acc.IsSigner = acc.IsSigner || raw.Signer
+
+	return nil
 }
 
 // A nested/recursive version of IdlAccount.
@@ -158,6 +192,9 @@ const (
 	IdlTypeString    IdlTypeAsString = "string"
 	IdlTypePublicKey IdlTypeAsString = "publicKey"
 
+	// idlTypePubkey is the Anchor 0.30+ name of publicKey and is converted to IdlTypePublicKey when unmarshalled.
+	idlTypePubkey IdlTypeAsString = "pubkey"
+
 	// Custom additions:
 	IdlTypeUnixTimestamp IdlTypeAsString = "unixTimestamp"
 	IdlTypeHash          IdlTypeAsString = "hash"
@@ -172,15 +209,53 @@ type IdlTypeOption struct {
 	Option IdlType `json:"option"`
 }
 
+type IdlTypeCOption struct {
+	COption IdlType `json:"coption"`
+}
+
 // User defined type.
 type IdlTypeDefined struct {
 	Defined string `json:"defined"`
+	// Generics are the arguments for a generic defined type in the Anchor 0.30+ format.
+	Generics []IdlGenericArg `json:"generics,omitempty"`
+}
+
+func (env *IdlTypeDefined) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Defined json.RawMessage `json:"defined"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	// legacy format: {"defined": "Name"}
+	if err := json.Unmarshal(raw.Defined, &env.Defined); err == nil {
+		return nil
+	}
+
+	// 0.30+ format: {"defined": {"name": "Name", "generics": [...]}}
+	var named struct {
+		Name     string          `json:"name"`
+		Generics []IdlGenericArg `json:"generics"`
+	}
+
+	if err := json.Unmarshal(raw.Defined, &named); err != nil {
+		return err
+	}
+
+	env.Defined = named.Name
+	env.Generics = named.Generics
+
+	return nil
 }
 
 // Wrapper type:
 type IdlTypeArray struct {
 	Thing IdlType
 	Num   int
+	// NumGeneric is the name of a const generic that defines the array length.
+	NumGeneric string
 }
 
 func (env *IdlType) UnmarshalJSON(data []byte) error {
@@ -196,11 +271,21 @@ func (env *IdlType) UnmarshalJSON(data []byte) error {
 	switch v := temp.(type) {
 	case string:
 		env.asString = IdlTypeAsString(v)
+		if env.asString == idlTypePubkey {
+			env.asString = IdlTypePublicKey
+		}
 	case map[string]interface{}:
 		if len(v) == 0 {
 			return nil
 		}
 
+		if generic, ok := v["generic"]; ok {
+			name, isString := generic.(string)
+			if !isString {
+				return fmt.Errorf("generic is not in expected format: %v", generic)
+			}
+			env.asGeneric = name
+		}
 		if _, ok := v["vec"]; ok {
 			var target IdlTypeVec
 			if err := utilz.TranscodeJSON(temp, &target); err != nil {
@@ -215,6 +300,13 @@ func (env *IdlType) UnmarshalJSON(data []byte) error {
 			}
 			env.asIdlTypeOption = &target
 		}
+		if _, ok := v["coption"]; ok {
+			var target IdlTypeCOption
+			if err := utilz.TranscodeJSON(temp, &target); err != nil {
+				return err
+			}
+			env.asIdlTypeCOption = &target
+		}
 		if _, ok := v["defined"]; ok {
 			var target IdlTypeDefined
 			if err := utilz.TranscodeJSON(temp, &target); err != nil {
@@ -235,7 +327,18 @@ func (env *IdlType) UnmarshalJSON(data []byte) error {
 				return err
 			}
 
-			target.Num = int(arrVal[1].(float64))
+			switch num := arrVal[1].(type) {
+			case float64:
+				target.Num = int(num)
+			case map[string]interface{}:
+				generic, ok := num["generic"].(string)
+				if !ok {
+					return fmt.Errorf("array length is not in expected format: %v", num)
+				}
+				target.NumGeneric = generic
+			default:
+				return fmt.Errorf("array length is not in expected format: %v", num)
+			}
 
 			env.asIdlTypeArray = &target
 		}
@@ -251,8 +354,43 @@ type IdlType struct {
 	asString         IdlTypeAsString
 	asIdlTypeVec     *IdlTypeVec
 	asIdlTypeOption  *IdlTypeOption
+	asIdlTypeCOption *IdlTypeCOption
 	asIdlTypeDefined *IdlTypeDefined
 	asIdlTypeArray   *IdlTypeArray
+	asGeneric        string
+}
+
+func (env IdlType) MarshalJSON() ([]byte, error) {
+	switch {
+	case env.IsString():
+		return json.Marshal(env.asString)
+	case env.IsGeneric():
+		return json.Marshal(map[string]string{"generic": env.asGeneric})
+	case env.IsIdlTypeVec():
+		return json.Marshal(env.asIdlTypeVec)
+	case env.IsIdlTypeOption():
+		return json.Marshal(env.asIdlTypeOption)
+	case env.IsIdlTypeCOption():
+		return json.Marshal(env.asIdlTypeCOption)
+	case env.IsIdlTypeDefined():
+		if len(env.asIdlTypeDefined.Generics) == 0 {
+			return json.Marshal(map[string]string{"defined": env.asIdlTypeDefined.Defined})
+		}
+
+		return json.Marshal(map[string]any{"defined": map[string]any{
+			"name":     env.asIdlTypeDefined.Defined,
+			"generics": env.asIdlTypeDefined.Generics,
+		}})
+	case env.IsArray():
+		var num any = env.asIdlTypeArray.Num
+		if env.asIdlTypeArray.NumGeneric != "" {
+			num = map[string]string{"generic": env.asIdlTypeArray.NumGeneric}
+		}
+
+		return json.Marshal(map[string][]any{"array": {env.asIdlTypeArray.Thing, num}})
+	default:
+		return []byte("null"), nil
+	}
 }
 
 func (env *IdlType) IsString() bool {
@@ -264,12 +402,18 @@ func (env *IdlType) IsIdlTypeVec() bool {
 func (env *IdlType) IsIdlTypeOption() bool {
 	return env.asIdlTypeOption != nil
 }
+func (env *IdlType) IsIdlTypeCOption() bool {
+	return env.asIdlTypeCOption != nil
+}
 func (env *IdlType) IsIdlTypeDefined() bool {
 	return env.asIdlTypeDefined != nil
 }
 func (env *IdlType) IsArray() bool {
 	return env.asIdlTypeArray != nil
 }
+func (env *IdlType) IsGeneric() bool {
+	return env.asGeneric != ""
+}
 
 // Getters:
 func (env *IdlType) GetString() IdlTypeAsString {
@@ -281,16 +425,27 @@ func (env *IdlType) GetIdlTypeVec() *IdlTypeVec {
 func (env *IdlType) GetIdlTypeOption() *IdlTypeOption {
 	return env.asIdlTypeOption
 }
+func (env *IdlType) GetIdlTypeCOption() *IdlTypeCOption {
+	return env.asIdlTypeCOption
+}
 func (env *IdlType) GetIdlTypeDefined() *IdlTypeDefined {
 	return env.asIdlTypeDefined
 }
 func (env *IdlType) GetArray() *IdlTypeArray {
 	return env.asIdlTypeArray
 }
+func (env *IdlType) GetGeneric() string {
+	return env.asGeneric
+}
 
 type IdlTypeDef struct {
 	Name string       `json:"name"`
 	Type IdlTypeDefTy `json:"type"`
+
+	// The following are only available in the Anchor 0.30+ format.
+	Discriminator IdlDiscriminator    `json:"discriminator,omitempty"`
+	Serialization string              `json:"serialization,omitempty"`
+	Generics      []IdlTypeDefGeneric `json:"generics,omitempty"`
 }
 
 type IdlTypeDefTyKind string
@@ -298,6 +453,8 @@ type IdlTypeDefTyKind string
 const (
 	IdlTypeDefTyKindStruct IdlTypeDefTyKind = "struct"
 	IdlTypeDefTyKindEnum   IdlTypeDefTyKind = "enum"
+	// IdlTypeDefTyKindType is a type alias in the Anchor 0.30+ format.
+	IdlTypeDefTyKindType IdlTypeDefTyKind = "type"
 )
 
 type IdlTypeDefTyStruct struct {
@@ -317,6 +474,53 @@ type IdlTypeDefTy struct {
 
 	Fields   *IdlTypeDefStruct   `json:"fields,omitempty"`
 	Variants IdlEnumVariantSlice `json:"variants,omitempty"`
+	Alias    *IdlType            `json:"alias,omitempty"`
+}
+
+// UnmarshalJSON accepts tuple structs from the Anchor 0.30+ format where fields are types without names. Tuple
+// fields are named `field0`, `field1`, ... in the same way as tuple enum variants.
+func (env *IdlTypeDefTy) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Kind     IdlTypeDefTyKind    `json:"kind"`
+		Fields   []json.RawMessage   `json:"fields,omitempty"`
+		Variants IdlEnumVariantSlice `json:"variants,omitempty"`
+		Alias    *IdlType            `json:"alias,omitempty"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	env.Kind = raw.Kind
+	env.Variants = raw.Variants
+	env.Alias = raw.Alias
+
+	if raw.Fields == nil {
+		return nil
+	}
+
+	fields := make(IdlTypeDefStruct, len(raw.Fields))
+	for idx, rawField := range raw.Fields {
+		var named map[string]json.RawMessage
+		if err := json.Unmarshal(rawField, &named); err == nil {
+			if _, hasName := named["name"]; hasName {
+				if err = json.Unmarshal(rawField, &fields[idx]); err != nil {
+					return err
+				}
+
+				continue
+			}
+		}
+
+		fields[idx].Name = "field" + strconv.Itoa(idx)
+		if err := json.Unmarshal(rawField, &fields[idx].Type); err != nil {
+			return err
+		}
+	}
+
+	env.Fields = &fields
+
+	return nil
 }
 
 type IdlEnumVariantSlice []IdlEnumVariant
@@ -357,7 +561,6 @@ type IdlEnumFieldsNamed []IdlField
 
 type IdlEnumFieldsTuple []IdlType
 
-// TODO: verify with examples
 func (env *IdlEnumFields) UnmarshalJSON(data []byte) error {
 	var temp interface{}
 	if err := json.Unmarshal(data, &temp); err != nil {
@@ -374,11 +577,11 @@ func (env *IdlEnumFields) UnmarshalJSON(data []byte) error {
 			return nil
 		}
 
-		firstItem := v[0]
+		// Named fields are objects with a `name` property. Tuple fields are plain types which can be strings
+		// (ex: "u64") or objects without a name (ex: {"defined": "Foo"}).
+		firstItem, isObject := v[0].(map[string]interface{})
 
-		if _, ok := firstItem.(map[string]interface{})["name"]; ok {
-			// TODO:
-			// If has `name` field, then it's most likely a IdlEnumFieldsNamed.
+		if _, hasName := firstItem["name"]; isObject && hasName {
 			if err := utilz.TranscodeJSON(temp, &env.IdlEnumFieldsNamed); err != nil {
 				return err
 			}
@@ -0,0 +1,147 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+/*
+Anchor 0.30 changed the IDL specification (https://github.com/coral-xyz/anchor/blob/v0.30.1/idl/spec/src/lib.rs).
+The new format is detected by the `metadata.spec` field and is converted to the legacy structure so that codecs can
+be built from either format. The main differences are:
+
+  - name and version moved to `metadata`
+  - accounts and events only declare a name and discriminator, their layout is found in `types`
+  - instructions, accounts and events declare their discriminators instead of deriving them from the name
+  - `writable` and `signer` replace `isMut` and `isSigner`
+  - `pubkey` replaces `publicKey`
+  - defined types can be generic and structs can have unnamed (tuple) fields
+*/
+
+// IdlDiscriminator is a byte slice that is represented as an array of numbers in JSON.
+type IdlDiscriminator []byte
+
+func (d IdlDiscriminator) MarshalJSON() ([]byte, error) {
+	// a byte slice would be marshalled as base64
+	numbers := make([]int, len(d))
+	for idx, value := range d {
+		numbers[idx] = int(value)
+	}
+
+	return json.Marshal(numbers)
+}
+
+func (d *IdlDiscriminator) UnmarshalJSON(data []byte) error {
+	var numbers []int
+	if err := json.Unmarshal(data, &numbers); err != nil {
+		return err
+	}
+
+	*d = make(IdlDiscriminator, len(numbers))
+	for idx, number := range numbers {
+		if number < 0 || number > 255 {
+			return fmt.Errorf("discriminator value out of range: %d", number)
+		}
+
+		(*d)[idx] = uint8(number)
+	}
+
+	return nil
+}
+
+type IdlGenericKind string
+
+const (
+	IdlGenericKindType  IdlGenericKind = "type"
+	IdlGenericKindConst IdlGenericKind = "const"
+)
+
+// IdlTypeDefGeneric is a generic parameter of a defined type.
+type IdlTypeDefGeneric struct {
+	Kind IdlGenericKind `json:"kind"`
+	Name string         `json:"name"`
+	// Type is the type of a const generic (ex: usize)
+	Type string `json:"type,omitempty"`
+}
+
+// IdlGenericArg is the argument for a generic parameter where a defined type is used.
+type IdlGenericArg struct {
+	Kind  IdlGenericKind `json:"kind"`
+	Type  *IdlType       `json:"type,omitempty"`
+	Value string         `json:"value,omitempty"`
+}
+
+type IdlMetadata struct {
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Spec        string `json:"spec"`
+	Description string `json:"description,omitempty"`
+}
+
+// UnmarshalJSON detects the IDL format and converts the Anchor 0.30+ format to the legacy structure.
+func (idl *IDL) UnmarshalJSON(data []byte) error {
+	type legacyIDL IDL
+
+	var raw struct {
+		legacyIDL
+		Metadata *IdlMetadata `json:"metadata,omitempty"`
+	}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*idl = IDL(raw.legacyIDL)
+
+	if raw.Metadata == nil || raw.Metadata.Spec == "" {
+		return nil
+	}
+
+	idl.Name = raw.Metadata.Name
+	idl.Version = raw.Metadata.Version
+
+	return idl.resolveDeclarations()
+}
+
+// resolveDeclarations copies the layout of accounts and events from types as the 0.30+ format only declares names
+// and discriminators for them.
+func (idl *IDL) resolveDeclarations() error {
+	for idx, account := range idl.Accounts {
+		if account.Type.Kind != "" {
+			continue
+		}
+
+		def := idl.Types.GetByName(account.Name)
+		if def == nil {
+			return fmt.Errorf("type for account %s is not defined", account.Name)
+		}
+
+		idl.Accounts[idx].Type = def.Type
+		idl.Accounts[idx].Serialization = def.Serialization
+		idl.Accounts[idx].Generics = def.Generics
+	}
+
+	for idx, event := range idl.Events {
+		if len(event.Fields) != 0 {
+			continue
+		}
+
+		def := idl.Types.GetByName(event.Name)
+		if def == nil {
+			return fmt.Errorf("type for event %s is not defined", event.Name)
+		}
+
+		if def.Type.Kind != IdlTypeDefTyKindStruct || def.Type.Fields == nil {
+			return fmt.Errorf("type for event %s is not a struct", event.Name)
+		}
+
+		fields := make([]IdlEventField, len(*def.Type.Fields))
+		for fieldIdx, field := range *def.Type.Fields {
+			fields[fieldIdx] = IdlEventField{Name: field.Name, Type: field.Type}
+		}
+
+		idl.Events[idx].Fields = fields
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package codec_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+)
+
+func TestIDL_UnmarshalJSON_Anchor030(t *testing.T) {
+	t.Parallel()
+
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(anchor030IDL), &idl))
+
+	assert.Equal(t, "counter", idl.Name)
+	assert.Equal(t, "0.1.0", idl.Version)
+	assert.Equal(t, "Counter111111111111111111111111111111111111", idl.Address)
+
+	require.Len(t, idl.Instructions, 1)
+	instruction := idl.Instructions[0]
+	assert.Equal(t, codec.IdlDiscriminator{1, 2, 3, 4, 5, 6, 7, 8}, instruction.Discriminator)
+	require.Len(t, instruction.Accounts, 3)
+	assert.True(t, instruction.Accounts[0].IdlAccount.IsMut)
+	assert.False(t, instruction.Accounts[0].IdlAccount.IsSigner)
+	assert.True(t, instruction.Accounts[1].IdlAccount.IsMut)
+	assert.True(t, instruction.Accounts[1].IdlAccount.IsSigner)
+	assert.Equal(t, "11111111111111111111111111111111", instruction.Accounts[2].IdlAccount.Address)
+	require.NotNil(t, instruction.Returns)
+	assert.Equal(t, codec.IdlTypeU64, instruction.Returns.GetString())
+
+	require.Len(t, idl.Accounts, 1)
+	account := idl.Accounts[0]
+	assert.Equal(t, codec.IdlDiscriminator{255, 176, 4, 245, 188, 253, 124, 25}, account.Discriminator)
+	require.NotNil(t, account.Type.Fields)
+	assert.Equal(t, codec.IdlTypePublicKey, (*account.Type.Fields)[0].Type.GetString())
+
+	require.Len(t, idl.Events, 1)
+	require.Len(t, idl.Events[0].Fields, 2)
+	assert.Equal(t, "count", idl.Events[0].Fields[1].Name)
+
+	tuple := idl.Types.GetByName("Tuple")
+	require.NotNil(t, tuple)
+	require.Len(t, *tuple.Type.Fields, 2)
+	assert.Equal(t, "field1", (*tuple.Type.Fields)[1].Name)
+}
+
+func TestIDL_UnmarshalJSON_Anchor030MissingType(t *testing.T) {
+	t.Parallel()
+
+	var idl codec.IDL
+	require.Error(t, json.Unmarshal([]byte(`{"metadata": {"name": "a", "version": "0.1.0", "spec": "0.1.0"}, "accounts": [{"name": "Missing", "discriminator": [1]}]}`), &idl))
+}
+
+func TestNewIDLAccountCodec_Anchor030(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(anchor030IDL), &idl))
+
+	entry, err := codec.NewIDLAccountCodec(idl, binary.LittleEndian())
+	require.NoError(t, err)
+
+	type pair struct {
+		Key    uint64
+		Values []uint16
+	}
+
+	type counter struct {
+		Authority [32]byte
+		Count     uint64
+		Pair      pair
+		Tuple     struct {
+			Field0 uint8
+			Field1 bool
+		}
+		Alias uint32
+	}
+
+	expected := counter{
+		Authority: [32]byte{1},
+		Count:     2,
+		Pair:      pair{Key: 3, Values: []uint16{4, 5}},
+		Alias:     6,
+	}
+	expected.Tuple.Field0 = 7
+	expected.Tuple.Field1 = true
+
+	encoded, err := entry.Encode(ctx, expected, "Counter")
+	require.NoError(t, err)
+
+	// declared discriminator and a pair with an array of two u16 values
+	assert.Equal(t, []byte{255, 176, 4, 245, 188, 253, 124, 25}, encoded[:8])
+	require.Len(t, encoded, 8+32+8+8+4+1+1+4)
+
+	var decoded counter
+	require.NoError(t, entry.Decode(ctx, encoded, &decoded, "Counter"))
+	assert.Equal(t, expected, decoded)
+}
+
+func TestNewIDLAccountCodec_Anchor030InvalidGenerics(t *testing.T) {
+	t.Parallel()
+
+	for name, replacement := range map[string]string{
+		"missing arguments": `{"defined": {"name": "Pair"}}`,
+		"wrong kind":        `{"defined": {"name": "Pair", "generics": [{"kind": "const", "value": "2"}, {"kind": "const", "value": "2"}]}}`,
+		"invalid length":    `{"defined": {"name": "Pair", "generics": [{"kind": "type", "type": "u16"}, {"kind": "const", "value": "two"}]}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			raw := strings.Replace(anchor030IDL, `{"defined": {"name": "Pair", "generics": [{"kind": "type", "type": "u16"}, {"kind": "const", "value": "2"}]}}`, replacement, 1)
+
+			var idl codec.IDL
+			require.NoError(t, json.Unmarshal([]byte(raw), &idl))
+
+			_, err := codec.NewIDLAccountCodec(idl, binary.LittleEndian())
+			require.ErrorIs(t, err, types.ErrInvalidConfig)
+		})
+	}
+}
+
+const anchor030IDL = `{
+	"address": "Counter111111111111111111111111111111111111",
+	"metadata": {"name": "counter", "version": "0.1.0", "spec": "0.1.0"},
+	"instructions": [{
+		"name": "increment",
+		"discriminator": [1, 2, 3, 4, 5, 6, 7, 8],
+		"accounts": [
+			{"name": "counter", "writable": true},
+			{"name": "authority", "writable": true, "signer": true},
+			{"name": "system_program", "address": "11111111111111111111111111111111"}
+		],
+		"args": [{"name": "amount", "type": "u64"}],
+		"returns": "u64"
+	}],
+	"accounts": [{"name": "Counter", "discriminator": [255, 176, 4, 245, 188, 253, 124, 25]}],
+	"events": [{"name": "Incremented", "discriminator": [9, 10, 11, 12, 13, 14, 15, 16]}],
+	"types": [{
+		"name": "Counter",
+		"type": {
+			"kind": "struct",
+			"fields": [
+				{"name": "authority", "type": "pubkey"},
+				{"name": "count", "type": "u64"},
+				{"name": "pair", "type": {"defined": {"name": "Pair", "generics": [{"kind": "type", "type": "u16"}, {"kind": "const", "value": "2"}]}}},
+				{"name": "tuple", "type": {"defined": {"name": "Tuple"}}},
+				{"name": "alias", "type": {"defined": {"name": "Alias"}}}
+			]
+		}
+	}, {
+		"name": "Pair",
+		"generics": [{"kind": "type", "name": "T"}, {"kind": "const", "name": "N", "type": "usize"}],
+		"type": {
+			"kind": "struct",
+			"fields": [
+				{"name": "key", "type": "u64"},
+				{"name": "values", "type": {"array": [{"generic": "T"}, {"generic": "N"}]}}
+			]
+		}
+	}, {
+		"name": "Tuple",
+		"type": {"kind": "struct", "fields": ["u8", "bool"]}
+	}, {
+		"name": "Alias",
+		"type": {"kind": "type", "alias": "u32"}
+	}, {
+		"name": "Incremented",
+		"type": {
+			"kind": "struct",
+			"fields": [
+				{"name": "authority", "type": "pubkey"},
+				{"name": "count", "type": "u64"}
+			]
+		}
+	}]
+}`
@@ -0,0 +1,75 @@
+package codec
+
+import (
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings"
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
+)
+
+// Builder extends encodings.Builder with the parts of a serialization format that differ between borsh and bincode.
+// Builders that only implement encodings.Builder are treated as borsh.
+type Builder interface {
+	encodings.Builder
+	// LengthPrefixSize is the number of bytes of the element count that precedes vectors, strings and bytes.
+	LengthPrefixSize() uint
+	// EnumTag returns the codec of the variant index of enums.
+	EnumTag() encodings.TypeCodec
+}
+
+const (
+	borshLengthPrefixSize   = 4
+	bincodeLengthPrefixSize = 8
+)
+
+// NewBorshBuilder returns a little endian builder with u32 length prefixes and u8 enum tags.
+func NewBorshBuilder() Builder {
+	le := binary.LittleEndian()
+	return &formatBuilder{Builder: le, lengthPrefixSize: borshLengthPrefixSize, enumTag: le.Uint8()}
+}
+
+// NewBincodeBuilder returns a builder for the default bincode configuration used by native Solana programs, which
+// is little endian with u64 length prefixes and u32 enum tags.
+func NewBincodeBuilder() Builder {
+	le := binary.LittleEndian()
+	return &formatBuilder{Builder: le, lengthPrefixSize: bincodeLengthPrefixSize, enumTag: le.Uint32()}
+}
+
+type formatBuilder struct {
+	encodings.Builder
+	lengthPrefixSize uint
+	enumTag          encodings.TypeCodec
+}
+
+func (b *formatBuilder) LengthPrefixSize() uint {
+	return b.lengthPrefixSize
+}
+
+func (b *formatBuilder) EnumTag() encodings.TypeCodec {
+	return b.enumTag
+}
+
+func lengthPrefixSize(builder encodings.Builder) uint {
+	if formatted, ok := builder.(Builder); ok {
+		return formatted.LengthPrefixSize()
+	}
+
+	return borshLengthPrefixSize
+}
+
+func enumTag(builder encodings.Builder) encodings.TypeCodec {
+	if formatted, ok := builder.(Builder); ok {
+		return formatted.EnumTag()
+	}
+
+	return builder.Uint8()
+}
+
+// maxStringLength is the largest length that fits the length prefix of the builder and is used to select the
+// prefix size of strings.
+func maxStringLength(builder encodings.Builder) uint {
+	size := lengthPrefixSize(builder)
+	if size >= 8 {
+		return ^uint(0)
+	}
+
+	return 1<<(8*size) - 1
+}
@@ -0,0 +1,185 @@
+package codec_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+)
+
+type testNativeState struct {
+	Version uint16
+	Name    string
+	Values  []uint32
+	Kind    uint32
+	Action  struct {
+		Noop *struct{}
+		Set  *struct{ Field0 uint64 }
+	}
+}
+
+func TestNewBincodeBuilder(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(nativeIDL), &idl))
+
+	entry, err := codec.NewIDLAccountCodecWithoutDiscriminator(idl, codec.NewBincodeBuilder())
+	require.NoError(t, err)
+
+	expected := testNativeState{Version: 1, Name: "ab", Values: []uint32{2}, Kind: 1}
+	expected.Action.Set = &struct{ Field0 uint64 }{Field0: 3}
+
+	encoded, err := entry.Encode(ctx, expected, "NativeState")
+	require.NoError(t, err)
+
+	assert.Equal(t, []byte{
+		1, 0, // version
+		2, 0, 0, 0, 0, 0, 0, 0, 'a', 'b', // u64 length prefixed string
+		1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, // u64 length prefixed vec
+		1, 0, 0, 0, // u32 simple enum tag
+		1, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0, // u32 enum tag and tuple variant
+	}, encoded)
+
+	var decoded testNativeState
+	require.NoError(t, entry.Decode(ctx, encoded, &decoded, "NativeState"))
+	assert.Equal(t, expected, decoded)
+}
+
+func TestNewBorshBuilder(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(nativeIDL), &idl))
+
+	borsh, err := codec.NewIDLAccountCodecWithoutDiscriminator(idl, codec.NewBorshBuilder())
+	require.NoError(t, err)
+
+	littleEndian, err := codec.NewIDLAccountCodecWithoutDiscriminator(idl, binary.LittleEndian())
+	require.NoError(t, err)
+
+	value := map[string]any{"Version": 1, "Name": "ab", "Values": []uint32{2}, "Kind": 0, "Action": map[string]any{"Noop": &struct{}{}}}
+
+	borshEncoded, err := borsh.Encode(ctx, value, "NativeState")
+	require.NoError(t, err)
+
+	littleEndianEncoded, err := littleEndian.Encode(ctx, value, "NativeState")
+	require.NoError(t, err)
+
+	// u32 length prefixes and u8 enum tags
+	assert.Equal(t, []byte{1, 0, 2, 0, 0, 0, 'a', 'b', 1, 0, 0, 0, 2, 0, 0, 0, 0, 0}, borshEncoded)
+	assert.Equal(t, borshEncoded, littleEndianEncoded)
+}
+
+func TestNewIDLAccountCodecWithoutDiscriminator_SPLTokenAccount(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(splTokenIDL), &idl))
+
+	entry, err := codec.NewIDLAccountCodecWithoutDiscriminator(idl, binary.LittleEndian())
+	require.NoError(t, err)
+
+	type tokenAccount struct {
+		Mint            [32]byte
+		Owner           [32]byte
+		Amount          uint64
+		Delegate        *[32]byte
+		State           uint8
+		IsNative        *uint64
+		DelegatedAmount uint64
+		CloseAuthority  *[32]byte
+	}
+
+	closeAuthority := [32]byte{3}
+	expected := tokenAccount{
+		Mint:           [32]byte{1},
+		Owner:          [32]byte{2},
+		Amount:         1_000,
+		State:          1,
+		CloseAuthority: &closeAuthority,
+	}
+
+	encoded, err := entry.Encode(ctx, expected, "Account")
+	require.NoError(t, err)
+
+	// spl_token::state::Account::LEN
+	require.Len(t, encoded, 165)
+
+	var decoded tokenAccount
+	require.NoError(t, entry.Decode(ctx, encoded, &decoded, "Account"))
+	assert.Equal(t, expected, decoded)
+}
+
+const nativeIDL = `{
+	"version": "0.1.0",
+	"name": "native",
+	"instructions": [],
+	"accounts": [{
+		"name": "NativeState",
+		"type": {
+			"kind": "struct",
+			"fields": [
+				{"name": "version", "type": "u16"},
+				{"name": "name", "type": "string"},
+				{"name": "values", "type": {"vec": "u32"}},
+				{"name": "kind", "type": {"defined": "Kind"}},
+				{"name": "action", "type": {"defined": "Action"}}
+			]
+		}
+	}],
+	"types": [{
+		"name": "Action",
+		"type": {
+			"kind": "enum",
+			"variants": [{"name": "noop"}, {"name": "set", "fields": ["u64"]}]
+		}
+	}, {
+		"name": "Kind",
+		"type": {
+			"kind": "enum",
+			"variants": [{"name": "first"}, {"name": "second"}]
+		}
+	}]
+}`
+
+const splTokenIDL = `{
+	"version": "0.1.0",
+	"name": "spl_token",
+	"instructions": [],
+	"accounts": [{
+		"name": "Account",
+		"type": {
+			"kind": "struct",
+			"fields": [
+				{"name": "mint", "type": "publicKey"},
+				{"name": "owner", "type": "publicKey"},
+				{"name": "amount", "type": "u64"},
+				{"name": "delegate", "type": {"coption": "publicKey"}},
+				{"name": "state", "type": {"defined": "AccountState"}},
+				{"name": "isNative", "type": {"coption": "u64"}},
+				{"name": "delegatedAmount", "type": "u64"},
+				{"name": "closeAuthority", "type": {"coption": "publicKey"}}
+			]
+		}
+	}],
+	"types": [{
+		"name": "AccountState",
+		"type": {
+			"kind": "enum",
+			"variants": [{"name": "uninitialized"}, {"name": "initialized"}, {"name": "frozen"}]
+		}
+	}]
+}`
@@ -17,6 +17,36 @@ func NewDiscriminator(name string) encodings.TypeCodec {
 	return &discriminator{hashPrefix: sum[:discriminatorLength]}
 }
 
+// NewDeclaredDiscriminator uses the discriminator as declared in an Anchor 0.30+ IDL, which can be of any length.
+func NewDeclaredDiscriminator(value []byte) encodings.TypeCodec {
+	return &discriminator{hashPrefix: value}
+}
+
+// accountDiscriminator prefers the declared discriminator of the account and falls back to the name derived one.
+func accountDiscriminator(def IdlTypeDef) encodings.TypeCodec {
+	if len(def.Discriminator) > 0 {
+		return NewDeclaredDiscriminator(def.Discriminator)
+	}
+
+	return NewDiscriminator(def.Name)
+}
+
+// AccountDiscriminator returns the discriminator that prefixes the data of the named account of the IDL.
+func AccountDiscriminator(idl IDL, name string) (IdlDiscriminator, error) {
+	def := idl.Accounts.GetByName(name)
+	if def == nil {
+		return nil, fmt.Errorf("%w: account %s not found in IDL", types.ErrInvalidConfig, name)
+	}
+
+	if len(def.Discriminator) > 0 {
+		return def.Discriminator, nil
+	}
+
+	sum := sha256.Sum256([]byte("account:" + name))
+
+	return sum[:discriminatorLength], nil
+}
+
 type discriminator struct {
 	hashPrefix []byte
 }
@@ -45,7 +75,7 @@ func (d discriminator) Encode(value any, into []byte) ([]byte, error) {
 }
 
 func (d discriminator) Decode(encoded []byte) (any, []byte, error) {
-	raw, remaining, err := encodings.SafeDecode(encoded, discriminatorLength, func(raw []byte) []byte { return raw })
+	raw, remaining, err := encodings.SafeDecode(encoded, len(d.hashPrefix), func(raw []byte) []byte { return raw })
 	if err != nil {
 		return nil, nil, err
 	}
@@ -63,9 +93,9 @@ func (d discriminator) GetType() reflect.Type {
 }
 
 func (d discriminator) Size(_ int) (int, error) {
-	return discriminatorLength, nil
+	return len(d.hashPrefix), nil
 }
 
 func (d discriminator) FixedSize() (int, error) {
-	return discriminatorLength, nil
+	return len(d.hashPrefix), nil
 }
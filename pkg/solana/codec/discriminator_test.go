@@ -2,6 +2,7 @@ package codec_test
 
 import (
 	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"reflect"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
 
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec/testutils"
 )
 
 func TestDiscriminator(t *testing.T) {
@@ -81,4 +83,40 @@ func TestDiscriminator(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 8, size)
 	})
+
+	t.Run("declared discriminators can have any length", func(t *testing.T) {
+		expected := []byte{1, 2, 3}
+		c := codec.NewDeclaredDiscriminator(expected)
+		encoded, err := c.Encode(nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, expected, encoded)
+		actual, remaining, err := c.Decode(append(encoded, 4))
+		require.NoError(t, err)
+		require.Equal(t, &expected, actual)
+		require.Equal(t, []byte{4}, remaining)
+		size, err := c.FixedSize()
+		require.NoError(t, err)
+		require.Equal(t, 3, size)
+	})
+}
+
+func TestAccountDiscriminator(t *testing.T) {
+	var legacy codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(testutils.JSONIDLWithAllTypes), &legacy))
+
+	discriminator, err := codec.AccountDiscriminator(legacy, testutils.TestStructWithNestedStruct)
+	require.NoError(t, err)
+
+	tmp := sha256.Sum256([]byte("account:" + testutils.TestStructWithNestedStruct))
+	require.Equal(t, codec.IdlDiscriminator(tmp[:8]), discriminator)
+
+	var declared codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(anchor030IDL), &declared))
+
+	discriminator, err = codec.AccountDiscriminator(declared, "Counter")
+	require.NoError(t, err)
+	require.Equal(t, codec.IdlDiscriminator{255, 176, 4, 245, 188, 253, 124, 25}, discriminator)
+
+	_, err = codec.AccountDiscriminator(declared, "Missing")
+	require.True(t, errors.Is(err, types.ErrInvalidConfig))
 }
@@ -0,0 +1,187 @@
+package codec
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"golang.org/x/text/cases"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+// tupleFieldPrefix is the Go field name prefix of unnamed enum variant fields (ex: `Field0`).
+const tupleFieldPrefix = "Field"
+
+// asEnum creates a codec for an Anchor enum where at least one variant carries fields. The Go type is a struct with
+// one pointer field per variant, of which exactly one must be set. Variants with named fields map to a struct with
+// those fields, tuple variants map to a struct with `Field0`, `Field1`, ... and unit variants map to an empty struct.
+func asEnum(def IdlTypeDef, refs *codecRefs, name string, caser cases.Caser) (string, encodings.TypeCodec, error) {
+	variants := make([]enumVariant, len(def.Type.Variants))
+	structFields := make([]reflect.StructField, len(def.Type.Variants))
+
+	for idx, variant := range def.Type.Variants {
+		named, err := enumVariantFields(name, variant, refs, caser)
+		if err != nil {
+			return name, nil, err
+		}
+
+		variantCodec, err := encodings.NewStructCodec(named)
+		if err != nil {
+			return name, nil, err
+		}
+
+		variants[idx] = enumVariant{name: caser.String(variant.Name), codec: variantCodec}
+		structFields[idx] = reflect.StructField{Name: variants[idx].name, Type: variantCodec.GetType()}
+	}
+
+	tpe, err := structOf(name, structFields)
+	if err != nil {
+		return name, nil, err
+	}
+
+	return name, &enum{tag: enumTag(refs.builder), variants: variants, tpe: tpe}, nil
+}
+
+func enumVariantFields(parentTypeName string, variant IdlEnumVariant, refs *codecRefs, caser cases.Caser) ([]encodings.NamedTypeCodec, error) {
+	if variant.Fields == nil {
+		return nil, nil
+	}
+
+	var named []encodings.NamedTypeCodec
+
+	switch {
+	case variant.Fields.IdlEnumFieldsNamed != nil:
+		for _, field := range *variant.Fields.IdlEnumFieldsNamed {
+			typedCodec, err := processFieldType(parentTypeName, field.Type, refs)
+			if err != nil {
+				return nil, err
+			}
+
+			named = append(named, encodings.NamedTypeCodec{Name: caser.String(field.Name), Codec: typedCodec})
+		}
+	case variant.Fields.IdlEnumFieldsTuple != nil:
+		for idx, fieldType := range *variant.Fields.IdlEnumFieldsTuple {
+			typedCodec, err := processFieldType(parentTypeName, fieldType, refs)
+			if err != nil {
+				return nil, err
+			}
+
+			named = append(named, encodings.NamedTypeCodec{Name: tupleFieldPrefix + strconv.Itoa(idx), Codec: typedCodec})
+		}
+	}
+
+	return named, nil
+}
+
+// structOf wraps reflect.StructOf which panics on invalid or duplicate field names.
+func structOf(name string, fields []reflect.StructField) (tpe reflect.Type, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: invalid enum %s: %v", types.ErrInvalidConfig, name, r)
+		}
+	}()
+
+	return reflect.PointerTo(reflect.StructOf(fields)), nil
+}
+
+type enumVariant struct {
+	name  string
+	codec encodings.TypeCodec
+}
+
+type enum struct {
+	tag      encodings.TypeCodec
+	variants []enumVariant
+	tpe      reflect.Type
+}
+
+var _ encodings.TypeCodec = &enum{}
+
+func (e *enum) Encode(value any, into []byte) ([]byte, error) {
+	rValue := reflect.ValueOf(value)
+	if !rValue.IsValid() || rValue.Type() != e.tpe || rValue.IsNil() {
+		return nil, fmt.Errorf("%w: expected %v, got %T", types.ErrInvalidType, e.tpe, value)
+	}
+
+	rValue = rValue.Elem()
+
+	selected := -1
+	for idx := range e.variants {
+		if rValue.Field(idx).IsNil() {
+			continue
+		}
+
+		if selected >= 0 {
+			return nil, fmt.Errorf("%w: only one enum variant can be set, got %s and %s", types.ErrInvalidType, e.variants[selected].name, e.variants[idx].name)
+		}
+
+		selected = idx
+	}
+
+	if selected < 0 {
+		return nil, fmt.Errorf("%w: exactly one enum variant must be set", types.ErrInvalidType)
+	}
+
+	into, err := e.tag.Encode(reflect.ValueOf(selected).Convert(e.tag.GetType()).Interface(), into)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.variants[selected].codec.Encode(rValue.Field(selected).Interface(), into)
+}
+
+func (e *enum) Decode(encoded []byte) (any, []byte, error) {
+	rawTag, remaining, err := e.tag.Decode(encoded)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tag := reflect.ValueOf(rawTag).Convert(reflect.TypeOf(uint64(0))).Uint()
+	if tag >= uint64(len(e.variants)) {
+		return nil, nil, fmt.Errorf("%w: invalid enum tag %d", types.ErrInvalidEncoding, tag)
+	}
+
+	value, remaining, err := e.variants[tag].codec.Decode(remaining)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rValue := reflect.New(e.tpe.Elem())
+	rValue.Elem().Field(int(tag)).Set(reflect.ValueOf(value))
+
+	return rValue.Interface(), remaining, nil
+}
+
+func (e *enum) GetType() reflect.Type {
+	return e.tpe
+}
+
+func (e *enum) Size(_ int) (int, error) {
+	return e.FixedSize()
+}
+
+// FixedSize is only available when all variants encode to the same number of bytes.
+func (e *enum) FixedSize() (int, error) {
+	tagSize, err := e.tag.FixedSize()
+	if err != nil {
+		return 0, err
+	}
+
+	size := -1
+	for _, variant := range e.variants {
+		variantSize, err := variant.codec.FixedSize()
+		if err != nil {
+			return 0, err
+		}
+
+		if size >= 0 && size != variantSize {
+			return 0, fmt.Errorf("%w: enum variants are not the same size", types.ErrInvalidType)
+		}
+
+		size = variantSize
+	}
+
+	return tagSize + size, nil
+}
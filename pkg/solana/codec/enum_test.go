@@ -0,0 +1,156 @@
+package codec_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+)
+
+type testInner struct {
+	Value uint16
+}
+
+type testTransfer struct {
+	Amount uint64
+	Memo   *string
+}
+
+type testSwap struct {
+	Field0 uint8
+	Field1 testInner
+}
+
+type testAction struct {
+	Noop     *struct{}
+	Transfer *testTransfer
+	Swap     *testSwap
+}
+
+type testEnumAccount struct {
+	Action    testAction
+	Maybe     *testInner
+	Authority *[32]byte
+}
+
+func TestNewIDLAccountCodec_Enums(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(enumIDL), &idl))
+
+	entry, err := codec.NewIDLAccountCodec(idl, binary.LittleEndian())
+	require.NoError(t, err)
+
+	memo := "memo"
+	authority := [32]byte{1, 2, 3}
+
+	for _, test := range []struct {
+		name     string
+		value    testEnumAccount
+		expected []byte
+	}{
+		{
+			name:     "unit variant",
+			value:    testEnumAccount{Action: testAction{Noop: &struct{}{}}},
+			expected: append([]byte{0, 0}, make([]byte, 36)...),
+		},
+		{
+			name:  "named variant",
+			value: testEnumAccount{Action: testAction{Transfer: &testTransfer{Amount: 5, Memo: &memo}}, Authority: &authority},
+			expected: append(
+				[]byte{1, 5, 0, 0, 0, 0, 0, 0, 0, 1, 4, 0, 0, 0, 'm', 'e', 'm', 'o', 0, 1, 0, 0, 0},
+				authority[:]...,
+			),
+		},
+		{
+			name:     "tuple variant",
+			value:    testEnumAccount{Action: testAction{Swap: &testSwap{Field0: 3, Field1: testInner{Value: 4}}}, Maybe: &testInner{Value: 6}},
+			expected: append([]byte{2, 3, 4, 0, 1, 6, 0}, make([]byte, 36)...),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			encoded, err := entry.Encode(ctx, test.value, "EnumAccount")
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, encoded[8:])
+
+			var decoded testEnumAccount
+			require.NoError(t, entry.Decode(ctx, encoded, &decoded, "EnumAccount"))
+			assert.Equal(t, test.value, decoded)
+		})
+	}
+
+	t.Run("exactly one variant must be set", func(t *testing.T) {
+		_, err := entry.Encode(ctx, testEnumAccount{}, "EnumAccount")
+		require.ErrorIs(t, err, types.ErrInvalidType)
+
+		_, err = entry.Encode(ctx, testEnumAccount{Action: testAction{Noop: &struct{}{}, Swap: &testSwap{}}}, "EnumAccount")
+		require.ErrorIs(t, err, types.ErrInvalidType)
+	})
+
+	t.Run("decode returns an error for an unknown variant", func(t *testing.T) {
+		encoded, err := entry.Encode(ctx, testEnumAccount{Action: testAction{Noop: &struct{}{}}}, "EnumAccount")
+		require.NoError(t, err)
+
+		encoded[8] = 3
+
+		var decoded testEnumAccount
+		require.ErrorIs(t, entry.Decode(ctx, encoded, &decoded, "EnumAccount"), types.ErrInvalidEncoding)
+	})
+
+	t.Run("create type", func(t *testing.T) {
+		created, err := entry.CreateType("EnumAccount", false)
+		require.NoError(t, err)
+
+		encoded, err := entry.Encode(ctx, testEnumAccount{Action: testAction{Swap: &testSwap{Field0: 1}}}, "EnumAccount")
+		require.NoError(t, err)
+		require.NoError(t, entry.Decode(ctx, encoded, created, "EnumAccount"))
+
+		reEncoded, err := entry.Encode(ctx, created, "EnumAccount")
+		require.NoError(t, err)
+		assert.Equal(t, encoded, reEncoded)
+	})
+}
+
+const enumIDL = `{
+	"version": "0.1.0",
+	"name": "enums",
+	"instructions": [],
+	"accounts": [{
+		"name": "EnumAccount",
+		"type": {
+			"kind": "struct",
+			"fields": [
+				{"name": "action", "type": {"defined": "Action"}},
+				{"name": "maybe", "type": {"option": {"defined": "Inner"}}},
+				{"name": "authority", "type": {"coption": "publicKey"}}
+			]
+		}
+	}],
+	"types": [{
+		"name": "Inner",
+		"type": {
+			"kind": "struct",
+			"fields": [{"name": "value", "type": "u16"}]
+		}
+	}, {
+		"name": "Action",
+		"type": {
+			"kind": "enum",
+			"variants": [
+				{"name": "noop"},
+				{"name": "transfer", "fields": [{"name": "amount", "type": "u64"}, {"name": "memo", "type": {"option": "string"}}]},
+				{"name": "swap", "fields": ["u8", {"defined": "Inner"}]}
+			]
+		}
+	}]
+}`
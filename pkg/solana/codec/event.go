@@ -0,0 +1,109 @@
+package codec
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+)
+
+// NewEventDiscriminator returns the discriminator of the event as derived by Anchor from its name.
+func NewEventDiscriminator(name string) IdlDiscriminator {
+	sum := sha256.Sum256([]byte("event:" + name))
+	return sum[:discriminatorLength]
+}
+
+// IDLEventCodec encodes and decodes the events of an IDL by name and can find the event of an encoded payload from
+// its discriminator.
+type IDLEventCodec struct {
+	types.RemoteCodec
+	events []IdlEvent
+}
+
+// NewIDLEventCodec is for Anchor events as emitted with `emit!` in `Program data:` logs. Events are prefixed with
+// their declared discriminator or the one derived from `event:<Name>` for legacy IDLs.
+func NewIDLEventCodec(idl IDL, builder encodings.Builder) (*IDLEventCodec, error) {
+	events := make([]IdlEvent, len(idl.Events))
+	defs := make(IdlTypeDefSlice, len(idl.Events))
+
+	for idx, event := range idl.Events {
+		fields := make(IdlTypeDefStruct, len(event.Fields))
+		for fieldIdx, field := range event.Fields {
+			fields[fieldIdx] = IdlField{Name: field.Name, Type: field.Type}
+		}
+
+		events[idx] = event
+		if len(events[idx].Discriminator) == 0 {
+			events[idx].Discriminator = NewEventDiscriminator(event.Name)
+		}
+
+		defs[idx] = IdlTypeDef{
+			Name:          event.Name,
+			Type:          IdlTypeDefTy{Kind: IdlTypeDefTyKindStruct, Fields: &fields},
+			Discriminator: events[idx].Discriminator,
+		}
+	}
+
+	remoteCodec, err := newIDLCoded(idl, builder, defs, true, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDLEventCodec{RemoteCodec: remoteCodec, events: events}, nil
+}
+
+// EventName returns the name of the event that the encoded data belongs to.
+func (c *IDLEventCodec) EventName(encoded []byte) (string, error) {
+	for _, event := range c.events {
+		if bytes.HasPrefix(encoded, event.Discriminator) {
+			return event.Name, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: unknown event discriminator %x", types.ErrInvalidEncoding, encoded[:min(len(encoded), discriminatorLength)])
+}
+
+// DecodeProgramData decodes the base64 payload of a `Program data:` log into a new value of the event type and
+// returns it with the event name.
+func (c *IDLEventCodec) DecodeProgramData(ctx context.Context, payload string) (string, any, error) {
+	encoded, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: failed to decode program data from base64: %s", types.ErrInvalidEncoding, err)
+	}
+
+	name, err := c.EventName(encoded)
+	if err != nil {
+		return "", nil, err
+	}
+
+	event, err := c.CreateType(name, false)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if err = c.Decode(ctx, encoded, event, name); err != nil {
+		return "", nil, err
+	}
+
+	return name, event, nil
+}
+
+// DecodeProgramDataToValue works like DecodeProgramData and wraps the event in a values.Value.
+func (c *IDLEventCodec) DecodeProgramDataToValue(ctx context.Context, payload string) (string, values.Value, error) {
+	name, event, err := c.DecodeProgramData(ctx, payload)
+	if err != nil {
+		return "", nil, err
+	}
+
+	value, err := values.Wrap(event)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return name, value, nil
+}
@@ -0,0 +1,124 @@
+package codec_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec/testutils"
+)
+
+// a NewTransmission event emitted by the OCR2 program
+const newTransmissionPayload = "gjbLTR5rT6gW2QgAAAPLxuP0SjlzlEc3F2dlPyLOzIAeQnF05dG067WUiq7xYyfUMAAAAAAAAAAAAAAADZTbSmIQCAEOCQ8EBgcFAwoLDA0CAAAAAADKmjsAAAAAiBMAAAAAAAA="
+
+func TestNewIDLEventCodec(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(testutils.OCR2IDL), &idl))
+
+	eventCodec, err := codec.NewIDLEventCodec(idl, binary.LittleEndian())
+	require.NoError(t, err)
+
+	t.Run("event discriminators are derived from the name", func(t *testing.T) {
+		sum := sha256.Sum256([]byte("event:NewTransmission"))
+		assert.Equal(t, codec.IdlDiscriminator(sum[:8]), codec.NewEventDiscriminator("NewTransmission"))
+
+		raw, err := base64.StdEncoding.DecodeString(newTransmissionPayload)
+		require.NoError(t, err)
+
+		name, err := eventCodec.EventName(raw)
+		require.NoError(t, err)
+		assert.Equal(t, "NewTransmission", name)
+
+		_, err = eventCodec.EventName([]byte{1, 2, 3})
+		require.ErrorIs(t, err, types.ErrInvalidEncoding)
+	})
+
+	t.Run("decode into a typed event", func(t *testing.T) {
+		type newTransmission struct {
+			RoundID         uint32
+			Answer          *big.Int
+			Transmitter     uint8
+			JuelsPerLamport uint64
+		}
+
+		raw, err := base64.StdEncoding.DecodeString(newTransmissionPayload)
+		require.NoError(t, err)
+
+		var decoded newTransmission
+		require.NoError(t, eventCodec.Decode(ctx, raw, &decoded, "NewTransmission"))
+
+		assert.Equal(t, newTransmission{
+			RoundID:         0x8d916,
+			Answer:          big.NewInt(0x30d42763f1),
+			Transmitter:     0xd,
+			JuelsPerLamport: 0x3b9aca00,
+		}, decoded)
+	})
+
+	t.Run("decode program data", func(t *testing.T) {
+		name, event, err := eventCodec.DecodeProgramData(ctx, newTransmissionPayload)
+		require.NoError(t, err)
+		assert.Equal(t, "NewTransmission", name)
+		require.NotNil(t, event)
+
+		encoded, err := eventCodec.Encode(ctx, event, name)
+		require.NoError(t, err)
+		assert.Equal(t, newTransmissionPayload, base64.StdEncoding.EncodeToString(encoded))
+
+		_, _, err = eventCodec.DecodeProgramData(ctx, "not base64!")
+		require.ErrorIs(t, err, types.ErrInvalidEncoding)
+	})
+
+	t.Run("decode program data to value", func(t *testing.T) {
+		name, value, err := eventCodec.DecodeProgramDataToValue(ctx, newTransmissionPayload)
+		require.NoError(t, err)
+		assert.Equal(t, "NewTransmission", name)
+
+		var decoded struct {
+			RoundID         uint32
+			JuelsPerLamport uint64
+		}
+
+		require.NoError(t, value.UnwrapTo(&decoded))
+		assert.Equal(t, uint32(0x8d916), decoded.RoundID)
+		assert.Equal(t, uint64(0x3b9aca00), decoded.JuelsPerLamport)
+
+		_, isMap := value.(*values.Map)
+		assert.True(t, isMap)
+	})
+}
+
+func TestNewIDLEventCodec_DeclaredDiscriminator(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(anchor030IDL), &idl))
+
+	eventCodec, err := codec.NewIDLEventCodec(idl, binary.LittleEndian())
+	require.NoError(t, err)
+
+	encoded, err := eventCodec.Encode(ctx, map[string]any{"Authority": [32]byte{1}, "Count": uint64(2)}, "Incremented")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{9, 10, 11, 12, 13, 14, 15, 16}, encoded[:8])
+
+	name, err := eventCodec.EventName(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, "Incremented", name)
+}
@@ -0,0 +1,216 @@
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+// typeDef returns the definition of a defined type. Generic types are instantiated with the provided arguments and
+// named after them (ex: `Pair<"u64",10>`) so that each instantiation has its own codec.
+func (refs *codecRefs) typeDef(defined *IdlTypeDefined) (*IdlTypeDef, error) {
+	def := refs.typeDefs.GetByName(defined.Defined)
+	if def == nil {
+		return nil, fmt.Errorf("%w: IDL type does not exist for name %s", types.ErrInvalidConfig, defined.Defined)
+	}
+
+	if len(def.Generics) != len(defined.Generics) {
+		return nil, fmt.Errorf("%w: type %s expects %d generic arguments, got %d", types.ErrInvalidConfig, def.Name, len(def.Generics), len(defined.Generics))
+	}
+
+	if len(def.Generics) == 0 {
+		return def, nil
+	}
+
+	name, err := definedTypeName(defined)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(map[string]IdlGenericArg, len(def.Generics))
+	for idx, generic := range def.Generics {
+		if generic.Kind != defined.Generics[idx].Kind {
+			return nil, fmt.Errorf("%w: generic %s of type %s expects a %s argument", types.ErrInvalidConfig, generic.Name, def.Name, generic.Kind)
+		}
+
+		params[generic.Name] = defined.Generics[idx]
+	}
+
+	instance := IdlTypeDef{Name: name, Type: IdlTypeDefTy{Kind: def.Type.Kind}}
+
+	if def.Type.Fields != nil {
+		fields := make(IdlTypeDefStruct, len(*def.Type.Fields))
+		for idx, field := range *def.Type.Fields {
+			fields[idx] = field
+			if fields[idx].Type, err = substituteGenerics(field.Type, params); err != nil {
+				return nil, err
+			}
+		}
+
+		instance.Type.Fields = &fields
+	}
+
+	if def.Type.Alias != nil {
+		alias, err := substituteGenerics(*def.Type.Alias, params)
+		if err != nil {
+			return nil, err
+		}
+
+		instance.Type.Alias = &alias
+	}
+
+	if len(def.Type.Variants) > 0 {
+		if instance.Type.Variants, err = substituteVariantGenerics(def.Type.Variants, params); err != nil {
+			return nil, err
+		}
+	}
+
+	return &instance, nil
+}
+
+// definedTypeName is the name of a defined type including its generic arguments.
+func definedTypeName(defined *IdlTypeDefined) (string, error) {
+	if len(defined.Generics) == 0 {
+		return defined.Defined, nil
+	}
+
+	args := make([]string, len(defined.Generics))
+	for idx, arg := range defined.Generics {
+		switch arg.Kind {
+		case IdlGenericKindConst:
+			args[idx] = arg.Value
+		case IdlGenericKindType:
+			if arg.Type == nil {
+				return "", fmt.Errorf("%w: generic type argument for %s is missing a type", types.ErrInvalidConfig, defined.Defined)
+			}
+
+			raw, err := json.Marshal(arg.Type)
+			if err != nil {
+				return "", err
+			}
+
+			args[idx] = string(raw)
+		default:
+			return "", fmt.Errorf("%w: unknown generic argument kind %s", types.ErrInvalidConfig, arg.Kind)
+		}
+	}
+
+	return defined.Defined + "<" + strings.Join(args, ",") + ">", nil
+}
+
+func substituteGenerics(idlType IdlType, params map[string]IdlGenericArg) (IdlType, error) {
+	var err error
+
+	switch {
+	case idlType.IsGeneric():
+		arg, ok := params[idlType.GetGeneric()]
+		if !ok || arg.Kind != IdlGenericKindType || arg.Type == nil {
+			return idlType, fmt.Errorf("%w: no type argument for generic %s", types.ErrInvalidConfig, idlType.GetGeneric())
+		}
+
+		return *arg.Type, nil
+	case idlType.IsIdlTypeVec():
+		vec := *idlType.GetIdlTypeVec()
+		vec.Vec, err = substituteGenerics(vec.Vec, params)
+
+		return IdlType{asIdlTypeVec: &vec}, err
+	case idlType.IsIdlTypeOption():
+		opt := *idlType.GetIdlTypeOption()
+		opt.Option, err = substituteGenerics(opt.Option, params)
+
+		return IdlType{asIdlTypeOption: &opt}, err
+	case idlType.IsIdlTypeCOption():
+		opt := *idlType.GetIdlTypeCOption()
+		opt.COption, err = substituteGenerics(opt.COption, params)
+
+		return IdlType{asIdlTypeCOption: &opt}, err
+	case idlType.IsArray():
+		array := *idlType.GetArray()
+		if array.Thing, err = substituteGenerics(array.Thing, params); err != nil {
+			return idlType, err
+		}
+
+		if array.NumGeneric != "" {
+			arg, ok := params[array.NumGeneric]
+			if !ok || arg.Kind != IdlGenericKindConst {
+				return idlType, fmt.Errorf("%w: no const argument for generic %s", types.ErrInvalidConfig, array.NumGeneric)
+			}
+
+			if array.Num, err = strconv.Atoi(arg.Value); err != nil {
+				return idlType, fmt.Errorf("%w: invalid array length %s: %s", types.ErrInvalidConfig, arg.Value, err)
+			}
+
+			array.NumGeneric = ""
+		}
+
+		return IdlType{asIdlTypeArray: &array}, nil
+	case idlType.IsIdlTypeDefined():
+		defined := *idlType.GetIdlTypeDefined()
+		defined.Generics = make([]IdlGenericArg, len(idlType.GetIdlTypeDefined().Generics))
+
+		for idx, arg := range idlType.GetIdlTypeDefined().Generics {
+			if arg.Type != nil {
+				argType, err := substituteGenerics(*arg.Type, params)
+				if err != nil {
+					return idlType, err
+				}
+
+				arg.Type = &argType
+			}
+
+			defined.Generics[idx] = arg
+		}
+
+		return IdlType{asIdlTypeDefined: &defined}, nil
+	default:
+		return idlType, nil
+	}
+}
+
+func substituteVariantGenerics(variants IdlEnumVariantSlice, params map[string]IdlGenericArg) (IdlEnumVariantSlice, error) {
+	substituted := make(IdlEnumVariantSlice, len(variants))
+
+	for idx, variant := range variants {
+		substituted[idx] = variant
+
+		if variant.Fields == nil {
+			continue
+		}
+
+		fields := IdlEnumFields{}
+
+		if variant.Fields.IdlEnumFieldsNamed != nil {
+			named := make(IdlEnumFieldsNamed, len(*variant.Fields.IdlEnumFieldsNamed))
+			for fieldIdx, field := range *variant.Fields.IdlEnumFieldsNamed {
+				var err error
+
+				named[fieldIdx] = field
+				if named[fieldIdx].Type, err = substituteGenerics(field.Type, params); err != nil {
+					return nil, err
+				}
+			}
+
+			fields.IdlEnumFieldsNamed = &named
+		}
+
+		if variant.Fields.IdlEnumFieldsTuple != nil {
+			tuple := make(IdlEnumFieldsTuple, len(*variant.Fields.IdlEnumFieldsTuple))
+			for fieldIdx, fieldType := range *variant.Fields.IdlEnumFieldsTuple {
+				var err error
+
+				if tuple[fieldIdx], err = substituteGenerics(fieldType, params); err != nil {
+					return nil, err
+				}
+			}
+
+			fields.IdlEnumFieldsTuple = &tuple
+		}
+
+		substituted[idx].Fields = &fields
+	}
+
+	return substituted, nil
+}
@@ -0,0 +1,68 @@
+package codec
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+const (
+	idlAccountSeed = "anchor:idl"
+	// discriminator, authority and the u32 length of the compressed IDL
+	idlAccountHeaderLength = discriminatorLength + solana.PublicKeyLength + 4
+	// MaxIDLLength bounds the decompressed IDL, the compressed data is read from an untrusted account
+	MaxIDLLength = 10 * 1024 * 1024
+)
+
+// IDLAddress returns the address of the canonical Anchor IDL account of a program, which is created with the seed
+// `anchor:idl` from the program signer PDA.
+func IDLAddress(programID solana.PublicKey) (solana.PublicKey, error) {
+	base, _, err := solana.FindProgramAddress([][]byte{}, programID)
+	if err != nil {
+		return solana.PublicKey{}, err
+	}
+
+	return solana.CreateWithSeed(base, idlAccountSeed, programID)
+}
+
+// DecodeIDLAccount returns the decompressed IDL JSON stored in an Anchor IDL account.
+func DecodeIDLAccount(data []byte) ([]byte, error) {
+	if len(data) < idlAccountHeaderLength {
+		return nil, fmt.Errorf("%w: IDL account of %d bytes is too short", types.ErrInvalidEncoding, len(data))
+	}
+
+	sum := sha256.Sum256([]byte("account:IdlAccount"))
+	if !bytes.Equal(data[:discriminatorLength], sum[:discriminatorLength]) {
+		return nil, fmt.Errorf("%w: not an IDL account", types.ErrInvalidEncoding)
+	}
+
+	length := binary.LittleEndian.Uint32(data[idlAccountHeaderLength-4 : idlAccountHeaderLength])
+	if uint64(len(data)-idlAccountHeaderLength) < uint64(length) {
+		return nil, fmt.Errorf("%w: IDL length %d exceeds account data", types.ErrInvalidEncoding, length)
+	}
+
+	reader, err := zlib.NewReader(bytes.NewReader(data[idlAccountHeaderLength : idlAccountHeaderLength+int(length)]))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decompress IDL: %s", types.ErrInvalidEncoding, err)
+	}
+
+	defer reader.Close()
+
+	raw, err := io.ReadAll(io.LimitReader(reader, MaxIDLLength+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to decompress IDL: %s", types.ErrInvalidEncoding, err)
+	}
+
+	if len(raw) > MaxIDLLength {
+		return nil, fmt.Errorf("%w: decompressed IDL exceeds %d bytes", types.ErrInvalidEncoding, MaxIDLLength)
+	}
+
+	return raw, nil
+}
@@ -0,0 +1,75 @@
+package codec_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec/testutils"
+)
+
+func TestIDLAddress(t *testing.T) {
+	t.Parallel()
+
+	programID := solana.MustPublicKeyFromBase58("cjg3oHmg9uuPsP8D6g29NWvhySJkdYdAo9D25PRbKXJ")
+
+	base, _, err := solana.FindProgramAddress([][]byte{}, programID)
+	require.NoError(t, err)
+
+	expected, err := solana.CreateWithSeed(base, "anchor:idl", programID)
+	require.NoError(t, err)
+
+	address, err := codec.IDLAddress(programID)
+	require.NoError(t, err)
+	assert.Equal(t, expected, address)
+}
+
+func TestDecodeIDLAccount(t *testing.T) {
+	t.Parallel()
+
+	raw := []byte(`{"version": "0.1.0", "name": "test"}`)
+
+	decoded, err := codec.DecodeIDLAccount(testutils.EncodeIDLAccount(raw))
+	require.NoError(t, err)
+	assert.Equal(t, raw, decoded)
+
+	t.Run("trailing account space is ignored", func(t *testing.T) {
+		decoded, err := codec.DecodeIDLAccount(append(testutils.EncodeIDLAccount(raw), make([]byte, 64)...))
+		require.NoError(t, err)
+		assert.Equal(t, raw, decoded)
+	})
+
+	t.Run("invalid accounts", func(t *testing.T) {
+		data := testutils.EncodeIDLAccount(raw)
+
+		_, err := codec.DecodeIDLAccount(data[:20])
+		require.ErrorIs(t, err, types.ErrInvalidEncoding)
+
+		wrongDiscriminator := bytes.Clone(data)
+		wrongDiscriminator[0]++
+		_, err = codec.DecodeIDLAccount(wrongDiscriminator)
+		require.ErrorIs(t, err, types.ErrInvalidEncoding)
+
+		_, err = codec.DecodeIDLAccount(data[:len(data)-1])
+		require.ErrorIs(t, err, types.ErrInvalidEncoding)
+
+		notCompressed := bytes.Clone(data)
+		notCompressed[44] = 0
+		_, err = codec.DecodeIDLAccount(notCompressed)
+		require.ErrorIs(t, err, types.ErrInvalidEncoding)
+	})
+
+	t.Run("decompressed size is limited", func(t *testing.T) {
+		_, err := codec.DecodeIDLAccount(testutils.EncodeIDLAccount(make([]byte, codec.MaxIDLLength)))
+		require.NoError(t, err)
+
+		_, err = codec.DecodeIDLAccount(testutils.EncodeIDLAccount(make([]byte, codec.MaxIDLLength+1)))
+		require.ErrorIs(t, err, types.ErrInvalidEncoding)
+	})
+}
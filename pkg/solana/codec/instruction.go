@@ -0,0 +1,79 @@
+package codec
+
+import (
+	"crypto/sha256"
+	"strings"
+	"unicode"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+// NewInstructionDiscriminator returns the discriminator Anchor derives for an instruction from the snake case name
+// of its handler.
+func NewInstructionDiscriminator(name string) IdlDiscriminator {
+	sum := sha256.Sum256([]byte("global:" + toSnakeCase(name)))
+	return sum[:discriminatorLength]
+}
+
+// NewIDLInstructionCodec encodes the arguments of IDL instructions as instruction data by instruction name. The data is
+// prefixed with the declared discriminator of the instruction or the one derived from its name for legacy IDLs.
+func NewIDLInstructionCodec(idl IDL, builder encodings.Builder) (types.RemoteCodec, error) {
+	defs := make(IdlTypeDefSlice, len(idl.Instructions))
+
+	for idx, instruction := range idl.Instructions {
+		fields := make(IdlTypeDefStruct, len(instruction.Args))
+		copy(fields, instruction.Args)
+
+		discriminator := instruction.Discriminator
+		if len(discriminator) == 0 {
+			discriminator = NewInstructionDiscriminator(instruction.Name)
+		}
+
+		defs[idx] = IdlTypeDef{
+			Name:          instruction.Name,
+			Type:          IdlTypeDefTy{Kind: IdlTypeDefTyKindStruct, Fields: &fields},
+			Discriminator: discriminator,
+		}
+	}
+
+	return newIDLCoded(idl, builder, defs, true, false)
+}
+
+// NewIDLInstructionReturnCodec decodes the data set with `set_return_data` by IDL instructions that declare a return
+// type, by instruction name.
+func NewIDLInstructionReturnCodec(idl IDL, builder encodings.Builder) (types.RemoteCodec, error) {
+	defs := make(IdlTypeDefSlice, 0, len(idl.Instructions))
+
+	for _, instruction := range idl.Instructions {
+		if instruction.Returns == nil {
+			continue
+		}
+
+		defs = append(defs, IdlTypeDef{
+			Name: instruction.Name,
+			Type: IdlTypeDefTy{Kind: IdlTypeDefTyKindType, Alias: instruction.Returns},
+		})
+	}
+
+	return newIDLCoded(idl, builder, defs, false, false)
+}
+
+// toSnakeCase converts the camel case instruction names of legacy IDLs back to the names of the handlers.
+func toSnakeCase(name string) string {
+	var builder strings.Builder
+
+	for idx, char := range name {
+		if unicode.IsUpper(char) {
+			if idx > 0 {
+				builder.WriteByte('_')
+			}
+
+			char = unicode.ToLower(char)
+		}
+
+		builder.WriteRune(char)
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,115 @@
+package codec_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+)
+
+func TestNewInstructionDiscriminator(t *testing.T) {
+	t.Parallel()
+
+	// store::Instruction_Query and store::Instruction_CreateFeed
+	assert.Equal(t, codec.IdlDiscriminator{39, 251, 130, 159, 46, 136, 164, 169}, codec.NewInstructionDiscriminator("query"))
+	assert.Equal(t, codec.IdlDiscriminator{173, 86, 95, 94, 13, 193, 67, 180}, codec.NewInstructionDiscriminator("createFeed"))
+	assert.Equal(t, codec.NewInstructionDiscriminator("createFeed"), codec.NewInstructionDiscriminator("create_feed"))
+}
+
+func TestNewIDLInstructionCodec(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(queryIDL), &idl))
+
+	entry, err := codec.NewIDLInstructionCodec(idl, binary.LittleEndian())
+	require.NoError(t, err)
+
+	encoded, err := entry.Encode(ctx, map[string]any{"Scope": map[string]any{"RoundData": map[string]any{"RoundId": 5}}}, "query")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{39, 251, 130, 159, 46, 136, 164, 169, 3, 5, 0, 0, 0}, encoded)
+
+	encoded, err = entry.Encode(ctx, map[string]any{"Scope": map[string]any{"LatestRoundData": struct{}{}}}, "query")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{39, 251, 130, 159, 46, 136, 164, 169, 4}, encoded)
+}
+
+func TestNewIDLInstructionReturnCodec(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(queryIDL), &idl))
+
+	_, err := codec.NewIDLInstructionReturnCodec(idl, binary.LittleEndian())
+	require.NoError(t, err)
+
+	idl.Instructions[0].Returns = &codec.IdlType{}
+	require.NoError(t, json.Unmarshal([]byte(`{"defined": "Round"}`), idl.Instructions[0].Returns))
+
+	entry, err := codec.NewIDLInstructionReturnCodec(idl, binary.LittleEndian())
+	require.NoError(t, err)
+
+	type round struct {
+		RoundID   uint32
+		Slot      uint64
+		Timestamp uint32
+		Answer    *big.Int
+	}
+
+	expected := round{RoundID: 1, Slot: 2, Timestamp: 3, Answer: big.NewInt(-4)}
+
+	encoded, err := entry.Encode(ctx, expected, "query")
+	require.NoError(t, err)
+	require.Len(t, encoded, 4+8+4+16)
+
+	var decoded round
+	require.NoError(t, entry.Decode(ctx, encoded, &decoded, "query"))
+	assert.Equal(t, expected, decoded)
+}
+
+// the query instruction of the store program
+const queryIDL = `{
+	"version": "1.0.0",
+	"name": "store",
+	"instructions": [{
+		"name": "query",
+		"accounts": [{"name": "feed", "isMut": false, "isSigner": false}],
+		"args": [{"name": "scope", "type": {"defined": "Scope"}}]
+	}],
+	"types": [{
+		"name": "Round",
+		"type": {
+			"kind": "struct",
+			"fields": [
+				{"name": "roundId", "type": "u32"},
+				{"name": "slot", "type": "u64"},
+				{"name": "timestamp", "type": "u32"},
+				{"name": "answer", "type": "i128"}
+			]
+		}
+	}, {
+		"name": "Scope",
+		"type": {
+			"kind": "enum",
+			"variants": [
+				{"name": "Version"},
+				{"name": "Decimals"},
+				{"name": "Description"},
+				{"name": "RoundData", "fields": [{"name": "roundId", "type": "u32"}]},
+				{"name": "LatestRoundData"},
+				{"name": "Aggregator"}
+			]
+		}
+	}]
+}`
@@ -0,0 +1,169 @@
+package codec
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+// NewOption creates a codec for a borsh encoded `Option<T>`, which is a single byte tag followed by the value
+// when the tag is 1. The Go type is a pointer to the value type where nil is `None`. Value types that are already
+// pointers, such as structs and *big.Int, are not wrapped again.
+func NewOption(elem encodings.TypeCodec, builder encodings.Builder) encodings.TypeCodec {
+	return newOption(elem, builder.Uint8(), false)
+}
+
+// NewCOption creates a codec for a `COption<T>` as used by SPL programs. The tag is a u32 and the value is always
+// present so that the encoded size does not change, it is zeroed when the tag is 0.
+func NewCOption(elem encodings.TypeCodec, builder encodings.Builder) (encodings.TypeCodec, error) {
+	if _, err := elem.FixedSize(); err != nil {
+		return nil, fmt.Errorf("%w: COption values must have a fixed size: %s", types.ErrInvalidConfig, err)
+	}
+
+	return newOption(elem, builder.Uint32(), true), nil
+}
+
+func newOption(elem encodings.TypeCodec, tag encodings.TypeCodec, fixed bool) *option {
+	_, nested := elem.(*option)
+
+	return &option{
+		elem:      elem,
+		tag:       tag,
+		fixed:     fixed,
+		asPointer: nested || elem.GetType().Kind() != reflect.Pointer,
+	}
+}
+
+type option struct {
+	elem  encodings.TypeCodec
+	tag   encodings.TypeCodec
+	fixed bool
+	// asPointer is set when the Go type of elem cannot be nil and has to be wrapped in a pointer
+	asPointer bool
+}
+
+var _ encodings.TypeCodec = &option{}
+
+func (o *option) Encode(value any, into []byte) ([]byte, error) {
+	rValue := reflect.ValueOf(value)
+
+	if value == nil || (rValue.Kind() == reflect.Pointer && rValue.IsNil()) {
+		into, err := o.encodeTag(0, into)
+		if err != nil {
+			return nil, err
+		}
+
+		if o.fixed {
+			size, err := o.elem.FixedSize()
+			if err != nil {
+				return nil, err
+			}
+
+			into = append(into, make([]byte, size)...)
+		}
+
+		return into, nil
+	}
+
+	if rValue.Type() != o.GetType() {
+		return nil, fmt.Errorf("%w: expected %v, got %T", types.ErrInvalidType, o.GetType(), value)
+	}
+
+	if o.asPointer {
+		value = rValue.Elem().Interface()
+	}
+
+	into, err := o.encodeTag(1, into)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.elem.Encode(value, into)
+}
+
+func (o *option) Decode(encoded []byte) (any, []byte, error) {
+	rawTag, remaining, err := o.tag.Decode(encoded)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch reflect.ValueOf(rawTag).Convert(reflect.TypeOf(uint64(0))).Uint() {
+	case 0:
+		if o.fixed {
+			size, err := o.elem.FixedSize()
+			if err != nil {
+				return nil, nil, err
+			}
+
+			if len(remaining) < size {
+				return nil, nil, fmt.Errorf("%w: not enough bytes to decode option", types.ErrInvalidEncoding)
+			}
+
+			remaining = remaining[size:]
+		}
+
+		return reflect.Zero(o.GetType()).Interface(), remaining, nil
+	case 1:
+		value, remaining, err := o.elem.Decode(remaining)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !o.asPointer {
+			return value, remaining, nil
+		}
+
+		ptr := reflect.New(o.elem.GetType())
+		ptr.Elem().Set(reflect.ValueOf(value))
+
+		return ptr.Interface(), remaining, nil
+	default:
+		return nil, nil, fmt.Errorf("%w: invalid option tag %v", types.ErrInvalidEncoding, rawTag)
+	}
+}
+
+func (o *option) GetType() reflect.Type {
+	if o.asPointer {
+		return reflect.PointerTo(o.elem.GetType())
+	}
+
+	return o.elem.GetType()
+}
+
+func (o *option) Size(numItems int) (int, error) {
+	tagSize, err := o.tag.FixedSize()
+	if err != nil {
+		return 0, err
+	}
+
+	size, err := o.elem.Size(numItems)
+	if err != nil {
+		return 0, err
+	}
+
+	return tagSize + size, nil
+}
+
+func (o *option) FixedSize() (int, error) {
+	if !o.fixed {
+		return 0, fmt.Errorf("%w: options are not fixed size", types.ErrInvalidType)
+	}
+
+	tagSize, err := o.tag.FixedSize()
+	if err != nil {
+		return 0, err
+	}
+
+	size, err := o.elem.FixedSize()
+	if err != nil {
+		return 0, err
+	}
+
+	return tagSize + size, nil
+}
+
+func (o *option) encodeTag(tag uint8, into []byte) ([]byte, error) {
+	return o.tag.Encode(reflect.ValueOf(tag).Convert(o.tag.GetType()).Interface(), into)
+}
@@ -0,0 +1,100 @@
+package codec_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+)
+
+func TestOption(t *testing.T) {
+	t.Parallel()
+
+	builder := binary.LittleEndian()
+	c := codec.NewOption(builder.Uint32(), builder)
+
+	t.Run("type is a pointer to the value", func(t *testing.T) {
+		require.Equal(t, reflect.TypeOf((*uint32)(nil)), c.GetType())
+	})
+
+	t.Run("encode and decode some", func(t *testing.T) {
+		value := uint32(42)
+		encoded, err := c.Encode(&value, nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte{1, 42, 0, 0, 0}, encoded)
+
+		decoded, remaining, err := c.Decode(encoded)
+		require.NoError(t, err)
+		require.Equal(t, &value, decoded)
+		require.Empty(t, remaining)
+	})
+
+	t.Run("encode and decode none", func(t *testing.T) {
+		encoded, err := c.Encode((*uint32)(nil), nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte{0}, encoded)
+
+		decoded, remaining, err := c.Decode(append(encoded, 7))
+		require.NoError(t, err)
+		require.Equal(t, (*uint32)(nil), decoded)
+		require.Equal(t, []byte{7}, remaining)
+	})
+
+	t.Run("decode returns an error for an invalid tag", func(t *testing.T) {
+		_, _, err := c.Decode([]byte{2, 42, 0, 0, 0})
+		require.ErrorIs(t, err, types.ErrInvalidEncoding)
+	})
+
+	t.Run("options are not fixed size", func(t *testing.T) {
+		_, err := c.FixedSize()
+		require.ErrorIs(t, err, types.ErrInvalidType)
+	})
+}
+
+func TestCOption(t *testing.T) {
+	t.Parallel()
+
+	builder := binary.LittleEndian()
+	c, err := codec.NewCOption(builder.Uint32(), builder)
+	require.NoError(t, err)
+
+	t.Run("encode and decode some", func(t *testing.T) {
+		value := uint32(42)
+		encoded, err := c.Encode(&value, nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte{1, 0, 0, 0, 42, 0, 0, 0}, encoded)
+
+		decoded, remaining, err := c.Decode(encoded)
+		require.NoError(t, err)
+		require.Equal(t, &value, decoded)
+		require.Empty(t, remaining)
+	})
+
+	t.Run("none keeps the value space", func(t *testing.T) {
+		encoded, err := c.Encode(nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 0}, encoded)
+
+		decoded, remaining, err := c.Decode(encoded)
+		require.NoError(t, err)
+		require.Equal(t, (*uint32)(nil), decoded)
+		require.Empty(t, remaining)
+
+		size, err := c.FixedSize()
+		require.NoError(t, err)
+		require.Equal(t, 8, size)
+	})
+
+	t.Run("values must be fixed size", func(t *testing.T) {
+		str, err := builder.String(32)
+		require.NoError(t, err)
+
+		_, err = codec.NewCOption(str, builder)
+		require.ErrorIs(t, err, types.ErrInvalidConfig)
+	})
+}
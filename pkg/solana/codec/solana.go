@@ -12,8 +12,16 @@ unixTimestamp -> int64
 publicKey -> [32]byte
 hash -> [32]byte
 
-Enums as an Anchor data structure are only supported in their basic form of uint8 values. Enums with variants are not
-supported at this time.
+Enums where no variant carries fields map to the enum tag type, which is uint8 for borsh and uint32 for bincode. Enums
+with named or tuple variant fields map to a struct with one pointer field per variant where exactly one field is set.
+Option<T> and COption<T> map to a pointer to the value type where nil is None.
+
+Zero-copy accounts are supported with NewIDLZeroCopyAccountCodec which follows C layout and alignment rules. Only fixed
+size types are allowed in zero-copy accounts. A fixed array directly followed by an unsigned integer field named `len`
+maps to a slice of `len` items.
+
+The layout of vector, string and bytes length prefixes and enum tags follows borsh unless the builder implements Builder,
+see NewBincodeBuilder.
 
 Modifiers can be provided to assist in modifying property names, adding properties, etc.
 */
@@ -21,7 +29,6 @@ package codec
 
 import (
 	"fmt"
-	"math"
 
 	"github.com/go-viper/mapstructure/v2"
 	"golang.org/x/text/cases"
@@ -62,15 +69,27 @@ func NewNamedModifierCodec(original types.RemoteCodec, itemType string, modifier
 
 // NewIDLAccountCodec is for Anchor custom types
 func NewIDLAccountCodec(idl IDL, builder encodings.Builder) (types.RemoteCodec, error) {
-	return newIDLCoded(idl, builder, idl.Accounts, true)
+	return newIDLCoded(idl, builder, idl.Accounts, true, false)
+}
+
+// NewIDLAccountCodecWithoutDiscriminator is for accounts that are not prefixed with an Anchor discriminator such as the
+// state of native and SPL programs.
+func NewIDLAccountCodecWithoutDiscriminator(idl IDL, builder encodings.Builder) (types.RemoteCodec, error) {
+	return newIDLCoded(idl, builder, idl.Accounts, false, false)
+}
+
+// NewIDLZeroCopyAccountCodec is for Anchor zero-copy accounts (`#[account(zero_copy)]`) which are
+// stored with C layout through bytemuck instead of being borsh encoded.
+func NewIDLZeroCopyAccountCodec(idl IDL, builder encodings.Builder) (types.RemoteCodec, error) {
+	return newIDLCoded(idl, builder, idl.Accounts, true, true)
 }
 
 func NewIDLDefinedTypesCodec(idl IDL, builder encodings.Builder) (types.RemoteCodec, error) {
-	return newIDLCoded(idl, builder, idl.Types, false)
+	return newIDLCoded(idl, builder, idl.Types, false, false)
 }
 
 func newIDLCoded(
-	idl IDL, builder encodings.Builder, from IdlTypeDefSlice, includeDiscriminator bool) (types.RemoteCodec, error) {
+	idl IDL, builder encodings.Builder, from IdlTypeDefSlice, includeDiscriminator, zeroCopy bool) (types.RemoteCodec, error) {
 	typeCodecs := make(encodings.LenientCodecFromTypeCodec)
 
 	refs := &codecRefs{
@@ -78,9 +97,15 @@ func newIDLCoded(
 		codecs:       make(map[string]encodings.TypeCodec),
 		typeDefs:     idl.Types,
 		dependencies: make(map[string][]string),
+		zeroCopy:     zeroCopy,
 	}
 
 	for _, def := range from {
+		// generic types can only be encoded where they are used with arguments
+		if len(def.Generics) > 0 {
+			continue
+		}
+
 		var (
 			name     string
 			accCodec encodings.TypeCodec
@@ -103,6 +128,8 @@ type codecRefs struct {
 	codecs       map[string]encodings.TypeCodec
 	typeDefs     IdlTypeDefSlice
 	dependencies map[string][]string
+	// zeroCopy lays out all structs with C layout rules instead of borsh
+	zeroCopy bool
 }
 
 func createNamedCodec(
@@ -115,14 +142,26 @@ func createNamedCodec(
 
 	switch def.Type.Kind {
 	case IdlTypeDefTyKindStruct:
+		if refs.zeroCopy {
+			return asCStruct(def, refs, name, caser, includeDiscriminator)
+		}
+
 		return asStruct(def, refs, name, caser, includeDiscriminator)
 	case IdlTypeDefTyKindEnum:
 		variants := def.Type.Variants
 		if !variants.IsAllUint8() {
-			return name, nil, fmt.Errorf("%w: variants are not supported", types.ErrInvalidConfig)
+			return asEnum(def, refs, name, caser)
 		}
 
-		return name, refs.builder.Uint8(), nil
+		return name, enumTag(refs.builder), nil
+	case IdlTypeDefTyKindType:
+		if def.Type.Alias == nil {
+			return name, nil, fmt.Errorf("%w: type alias %s has no aliased type", types.ErrInvalidConfig, name)
+		}
+
+		aliased, err := processFieldType(name, *def.Type.Alias, refs)
+
+		return name, aliased, err
 	default:
 		return name, nil, fmt.Errorf(unknownIDLFormat, types.ErrInvalidConfig, def.Type.Kind)
 	}
@@ -142,7 +181,7 @@ func asStruct(
 	named := make([]encodings.NamedTypeCodec, len(*def.Type.Fields)+desLen)
 
 	if includeDiscriminator {
-		named[0] = encodings.NamedTypeCodec{Name: "Discriminator" + name, Codec: NewDiscriminator(name)}
+		named[0] = encodings.NamedTypeCodec{Name: "Discriminator" + name, Codec: accountDiscriminator(def)}
 	}
 
 	for idx, field := range *def.Type.Fields {
@@ -170,8 +209,19 @@ func processFieldType(parentTypeName string, idlType IdlType, refs *codecRefs) (
 		return getCodecByStringType(idlType.GetString(), refs.builder)
 	case idlType.IsIdlTypeOption():
 		// Go doesn't have an `Option` type; use pointer to type instead
-		// this should be automatic in the codec
-		return processFieldType(parentTypeName, idlType.GetIdlTypeOption().Option, refs)
+		codec, err := processFieldType(parentTypeName, idlType.GetIdlTypeOption().Option, refs)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewOption(codec, refs.builder), nil
+	case idlType.IsIdlTypeCOption():
+		codec, err := processFieldType(parentTypeName, idlType.GetIdlTypeCOption().COption, refs)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewCOption(codec, refs.builder)
 	case idlType.IsIdlTypeDefined():
 		return asDefined(parentTypeName, idlType.GetIdlTypeDefined(), refs)
 	case idlType.IsArray():
@@ -188,24 +238,29 @@ func asDefined(parentTypeName string, definedName *IdlTypeDefined, refs *codecRe
 		return nil, fmt.Errorf("%w: defined type name should not be nil", types.ErrInvalidConfig)
 	}
 
+	name, err := definedTypeName(definedName)
+	if err != nil {
+		return nil, err
+	}
+
 	// already exists as a type in the typed codecs
-	if savedCodec, ok := refs.codecs[definedName.Defined]; ok {
+	if savedCodec, ok := refs.codecs[name]; ok {
 		return savedCodec, nil
 	}
 
 	// nextDef should not have a dependency on definedName
-	if !validDependency(refs, parentTypeName, definedName.Defined) {
-		return nil, fmt.Errorf("%w: circular dependency detected on %s -> %s relation", types.ErrInvalidConfig, parentTypeName, definedName.Defined)
+	if !validDependency(refs, parentTypeName, name) {
+		return nil, fmt.Errorf("%w: circular dependency detected on %s -> %s relation", types.ErrInvalidConfig, parentTypeName, name)
 	}
 
 	// codec by defined type doesn't exist
 	// process it using the provided typeDefs
-	nextDef := refs.typeDefs.GetByName(definedName.Defined)
-	if nextDef == nil {
-		return nil, fmt.Errorf("%w: IDL type does not exist for name %s", types.ErrInvalidConfig, definedName.Defined)
+	nextDef, err := refs.typeDef(definedName)
+	if err != nil {
+		return nil, err
 	}
 
-	saveDependency(refs, parentTypeName, definedName.Defined)
+	saveDependency(refs, parentTypeName, name)
 
 	newTypeName, newTypeCodec, err := createNamedCodec(*nextDef, refs, false)
 	if err != nil {
@@ -233,7 +288,7 @@ func asVec(parentTypeName string, idlVec *IdlTypeVec, refs *codecRefs) (encoding
 		return nil, err
 	}
 
-	b, err := refs.builder.Int(4)
+	b, err := refs.builder.Int(lengthPrefixSize(refs.builder))
 	if err != nil {
 		return nil, err
 	}
@@ -246,7 +301,7 @@ func getCodecByStringType(curType IdlTypeAsString, builder encodings.Builder) (e
 	case IdlTypeBool:
 		return builder.Bool(), nil
 	case IdlTypeString:
-		return builder.String(math.MaxUint32)
+		return builder.String(maxStringLength(builder))
 	case IdlTypeI8, IdlTypeI16, IdlTypeI32, IdlTypeI64, IdlTypeI128:
 		return getIntCodecByStringType(curType, builder)
 	case IdlTypeU8, IdlTypeU16, IdlTypeU32, IdlTypeU64, IdlTypeU128:
@@ -308,7 +363,7 @@ func getTimeCodecByStringType(curType IdlTypeAsString, builder encodings.Builder
 func getByteCodecByStringType(curType IdlTypeAsString, builder encodings.Builder) (encodings.TypeCodec, error) {
 	switch curType {
 	case IdlTypeBytes:
-		b, err := builder.Int(4)
+		b, err := builder.Int(lengthPrefixSize(builder))
 		if err != nil {
 			return nil, err
 		}
@@ -29,7 +29,7 @@ func TestNewIDLAccountCodec(t *testing.T) {
 	bts, err := entry.Encode(ctx, expected, testutils.TestStructWithNestedStruct)
 
 	// length of fields + discriminator
-	require.Equal(t, 262, len(bts))
+	require.Equal(t, 263, len(bts))
 
 	require.NoError(t, err)
 
@@ -50,7 +50,7 @@ func TestNewIDLDefinedTypesCodecCodec(t *testing.T) {
 	bts, err := entry.Encode(ctx, expected, testutils.TestStructWithNestedStructType)
 
 	// length of fields without a discriminator
-	require.Equal(t, 254, len(bts))
+	require.Equal(t, 255, len(bts))
 
 	require.NoError(t, err)
 
@@ -143,6 +143,132 @@ func TestNewIDLCodec_CircularDependency(t *testing.T) {
 	assert.ErrorIs(t, err, types.ErrInvalidConfig)
 }
 
+func TestNewIDLZeroCopyAccountCodec(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(zeroCopyIDL), &idl))
+
+	entry, err := codec.NewIDLZeroCopyAccountCodec(idl, binary.LittleEndian())
+	require.NoError(t, err)
+
+	type inner struct {
+		A uint8
+		B uint32
+	}
+
+	type zeroCopy struct {
+		Flag    uint8
+		Value   uint64
+		Count   uint16
+		Items   []inner
+		Trailer uint8
+	}
+
+	expected := zeroCopy{
+		Flag:    1,
+		Value:   2,
+		Count:   3,
+		Items:   []inner{{A: 4, B: 5}, {A: 6, B: 7}},
+		Trailer: 8,
+	}
+
+	bts, err := entry.Encode(ctx, expected, "ZeroCopy")
+	require.NoError(t, err)
+
+	// discriminator + u8 + 7 bytes padding + u64 + u16 + 2 bytes padding + 3 * (u8 + 3 bytes padding + u32)
+	// + 4 bytes padding + u64 len + u8 + 7 bytes trailing padding
+	require.Len(t, bts, 8+1+7+8+2+2+3*8+4+8+1+7)
+	assert.Equal(t, []byte{1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 4, 0, 0, 0, 5, 0, 0, 0}, bts[8:36])
+	assert.Equal(t, []byte{2, 0, 0, 0, 0, 0, 0, 0}, bts[56:64])
+
+	var decoded zeroCopy
+
+	require.NoError(t, entry.Decode(ctx, bts, &decoded, "ZeroCopy"))
+	require.Equal(t, expected, decoded)
+
+	expected.Items = make([]inner, 4)
+	_, err = entry.Encode(ctx, expected, "ZeroCopy")
+	require.ErrorIs(t, err, types.ErrSliceWrongLen)
+
+	// array length larger than capacity
+	bts[56] = 4
+	require.ErrorIs(t, entry.Decode(ctx, bts, &decoded, "ZeroCopy"), types.ErrInvalidEncoding)
+}
+
+func TestNewIDLZeroCopyAccountCodec_LenAlignment(t *testing.T) {
+	t.Parallel()
+
+	ctx := tests.Context(t)
+
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(zeroCopyLenAlignIDL), &idl))
+
+	entry, err := codec.NewIDLZeroCopyAccountCodec(idl, binary.LittleEndian())
+	require.NoError(t, err)
+
+	type lenAlign struct {
+		Raw     []uint8
+		Trailer uint8
+	}
+
+	expected := lenAlign{Raw: []uint8{1, 2}, Trailer: 3}
+
+	bts, err := entry.Encode(ctx, expected, "LenAlign")
+	require.NoError(t, err)
+
+	// discriminator + 3 * u8 + 5 bytes padding + u64 len + u8 + 7 bytes trailing padding to the u64 len alignment
+	require.Len(t, bts, 8+3+5+8+1+7)
+	assert.Equal(t, []byte{1, 2, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 0, 0, 0, 0}, bts[8:])
+
+	var decoded lenAlign
+
+	require.NoError(t, entry.Decode(ctx, bts, &decoded, "LenAlign"))
+	require.Equal(t, expected, decoded)
+}
+
+func TestNewIDLZeroCopyAccountCodec_UnsupportedTypes(t *testing.T) {
+	t.Parallel()
+
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(testutils.JSONIDLWithAllTypes), &idl))
+
+	_, err := codec.NewIDLZeroCopyAccountCodec(idl, binary.LittleEndian())
+	require.ErrorIs(t, err, types.ErrInvalidConfig)
+}
+
+const zeroCopyIDL = `{
+	"version": "0.1.0",
+	"name": "zero_copy",
+	"instructions": [],
+	"accounts": [{
+		"name": "ZeroCopy",
+		"type": {
+			"kind": "struct",
+			"fields": [
+				{"name": "flag", "type": "u8"},
+				{"name": "value", "type": "u64"},
+				{"name": "count", "type": "u16"},
+				{"name": "items", "type": {"array": [{"defined": "Inner"}, 3]}},
+				{"name": "len", "type": "u64"},
+				{"name": "trailer", "type": "u8"}
+			]
+		}
+	}],
+	"types": [{
+		"name": "Inner",
+		"type": {
+			"kind": "struct",
+			"fields": [
+				{"name": "a", "type": "u8"},
+				{"name": "b", "type": "u32"}
+			]
+		}
+	}]
+}`
+
 func newTestIDLAndCodec(t *testing.T, account bool) (string, codec.IDL, types.RemoteCodec) {
 	t.Helper()
 
@@ -169,3 +295,20 @@ func newTestIDLAndCodec(t *testing.T, account bool) (string, codec.IDL, types.Re
 
 	return testutils.JSONIDLWithAllTypes, idl, entry
 }
+
+const zeroCopyLenAlignIDL = `{
+	"version": "0.1.0",
+	"name": "zero_copy",
+	"instructions": [],
+	"accounts": [{
+		"name": "LenAlign",
+		"type": {
+			"kind": "struct",
+			"fields": [
+				{"name": "raw", "type": {"array": ["u8", 3]}},
+				{"name": "len", "type": "u64"},
+				{"name": "trailer", "type": "u8"}
+			]
+		}
+	}]
+}`
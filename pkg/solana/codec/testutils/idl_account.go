@@ -0,0 +1,25 @@
+package testutils
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// EncodeIDLAccount returns the data of an Anchor IDL account that holds the raw IDL JSON.
+func EncodeIDLAccount(raw []byte) []byte {
+	var compressed bytes.Buffer
+
+	// writes to a bytes.Buffer do not fail
+	writer := zlib.NewWriter(&compressed)
+	_, _ = writer.Write(raw)
+	_ = writer.Close()
+
+	sum := sha256.Sum256([]byte("account:IdlAccount"))
+	data := append([]byte{}, sum[:8]...)
+	data = append(data, make([]byte, 32)...) // authority
+	data = binary.LittleEndian.AppendUint32(data, uint32(compressed.Len()))
+
+	return append(data, compressed.Bytes()...)
+}
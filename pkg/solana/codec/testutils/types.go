@@ -6,6 +6,8 @@ import (
 	"time"
 
 	ag_solana "github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/idl"
 )
 
 var (
@@ -86,3 +88,6 @@ var JSONIDLWithAllTypes string
 
 //go:embed circularDepIDL.json
 var CircularDepIDL string
+
+// OCR2IDL is the IDL of the OCR2 program.
+var OCR2IDL = idl.OCR2
@@ -0,0 +1,345 @@
+package codec
+
+import (
+	"fmt"
+	"reflect"
+
+	"golang.org/x/text/cases"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings"
+	"github.com/smartcontractkit/chainlink-common/pkg/types"
+)
+
+// arrayLenFieldName is the name of the length field that follows a fixed array in zero-copy types
+// (ex: `arrayvec!(Oracles, Oracle, u64)` in the OCR2 program).
+const arrayLenFieldName = "len"
+
+// largeIntAlignment is the alignment of 128-bit integers and matches what deployed Solana programs
+// were compiled with.
+const largeIntAlignment = 8
+
+// asCStruct creates a struct codec for zero-copy types. Fields are laid out with C layout rules
+// (#[repr(C)]) where each field is aligned to its natural alignment and the struct is padded to a
+// multiple of its largest field alignment. A fixed array that is directly followed by an unsigned
+// integer field named `len` is decoded to a slice of `len` items.
+func asCStruct(
+	def IdlTypeDef,
+	refs *codecRefs,
+	name string,
+	caser cases.Caser,
+	includeDiscriminator bool,
+) (string, encodings.TypeCodec, error) {
+	fields := *def.Type.Fields
+	named := make([]encodings.NamedTypeCodec, 0, len(fields)+1)
+
+	if includeDiscriminator {
+		named = append(named, encodings.NamedTypeCodec{Name: "Discriminator" + name, Codec: accountDiscriminator(def)})
+	}
+
+	var offset, structAlign int
+
+	for idx := 0; idx < len(fields); idx++ {
+		field := fields[idx]
+
+		align, err := alignOf(name, field.Type, refs)
+		if err != nil {
+			return name, nil, err
+		}
+
+		pad := padding(offset, align)
+
+		var (
+			typedCodec encodings.TypeCodec
+			size       int
+		)
+
+		if idx+1 < len(fields) && isArrayWithLen(field, fields[idx+1]) {
+			// the struct is also aligned to the len field, which can have a larger alignment than the elements
+			var lenAlign int
+			if lenAlign, err = alignOf(name, fields[idx+1].Type, refs); err != nil {
+				return name, nil, err
+			}
+
+			structAlign = max(structAlign, lenAlign)
+			typedCodec, err = newArrayWithLen(name, field.Type.GetArray(), fields[idx+1].Type, offset+pad, refs)
+			idx++
+		} else {
+			typedCodec, err = processFieldType(name, field.Type, refs)
+		}
+
+		if err != nil {
+			return name, nil, err
+		}
+
+		if size, err = typedCodec.FixedSize(); err != nil {
+			return name, nil, fmt.Errorf("%w: zero-copy field %s.%s must have a fixed size: %s", types.ErrInvalidConfig, name, field.Name, err)
+		}
+
+		named = append(named, encodings.NamedTypeCodec{Name: caser.String(field.Name), Codec: &padded{codec: typedCodec, before: pad}})
+
+		offset += pad + size
+		structAlign = max(structAlign, align)
+	}
+
+	structCodec, err := encodings.NewStructCodec(named)
+	if err != nil {
+		return name, nil, err
+	}
+
+	if trailing := padding(offset, structAlign); trailing > 0 {
+		return name, &padded{codec: structCodec, after: trailing}, nil
+	}
+
+	return name, structCodec, nil
+}
+
+// alignOf returns the C layout alignment of an IDL type. Types that are not supported in zero-copy
+// accounts return an error.
+func alignOf(parentTypeName string, idlType IdlType, refs *codecRefs) (int, error) {
+	return alignOfWithPath(parentTypeName, idlType, refs, map[string]struct{}{parentTypeName: {}})
+}
+
+func alignOfWithPath(parentTypeName string, idlType IdlType, refs *codecRefs, path map[string]struct{}) (int, error) {
+	switch true {
+	case idlType.IsString():
+		switch idlType.GetString() {
+		case IdlTypeBool, IdlTypeU8, IdlTypeI8, IdlTypePublicKey, IdlTypeHash:
+			return 1, nil
+		case IdlTypeU16, IdlTypeI16:
+			return 2, nil
+		case IdlTypeU32, IdlTypeI32:
+			return 4, nil
+		case IdlTypeU64, IdlTypeI64, IdlTypeUnixTimestamp, IdlTypeDuration:
+			return 8, nil
+		case IdlTypeU128, IdlTypeI128:
+			return largeIntAlignment, nil
+		default:
+			return 0, fmt.Errorf("%w: type %s is not supported in zero-copy type %s", types.ErrInvalidConfig, idlType.GetString(), parentTypeName)
+		}
+	case idlType.IsArray():
+		return alignOfWithPath(parentTypeName, idlType.GetArray().Thing, refs, path)
+	case idlType.IsIdlTypeDefined():
+		def, err := refs.typeDef(idlType.GetIdlTypeDefined())
+		if err != nil {
+			return 0, err
+		}
+
+		name := def.Name
+
+		if def.Type.Kind != IdlTypeDefTyKindStruct {
+			return 0, fmt.Errorf("%w: type %s of kind %s is not supported in zero-copy type %s", types.ErrInvalidConfig, name, def.Type.Kind, parentTypeName)
+		}
+
+		if _, exists := path[name]; exists {
+			return 0, fmt.Errorf("%w: circular dependency detected on %s -> %s relation", types.ErrInvalidConfig, parentTypeName, name)
+		}
+
+		path[name] = struct{}{}
+		defer delete(path, name)
+
+		align := 1
+		for _, field := range *def.Type.Fields {
+			fieldAlign, err := alignOfWithPath(name, field.Type, refs, path)
+			if err != nil {
+				return 0, err
+			}
+
+			align = max(align, fieldAlign)
+		}
+
+		return align, nil
+	default:
+		return 0, fmt.Errorf("%w: only fixed size types are supported in zero-copy type %s", types.ErrInvalidConfig, parentTypeName)
+	}
+}
+
+func isArrayWithLen(field, next IdlField) bool {
+	if !field.Type.IsArray() || next.Name != arrayLenFieldName || !next.Type.IsString() {
+		return false
+	}
+
+	switch next.Type.GetString() {
+	case IdlTypeU8, IdlTypeU16, IdlTypeU32, IdlTypeU64:
+		return true
+	default:
+		return false
+	}
+}
+
+func newArrayWithLen(
+	parentTypeName string,
+	idlArray *IdlTypeArray,
+	lenType IdlType,
+	offset int,
+	refs *codecRefs,
+) (encodings.TypeCodec, error) {
+	elemCodec, err := processFieldType(parentTypeName, idlArray.Thing, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	elemSize, err := elemCodec.FixedSize()
+	if err != nil {
+		return nil, fmt.Errorf("%w: zero-copy array elements in %s must have a fixed size: %s", types.ErrInvalidConfig, parentTypeName, err)
+	}
+
+	lenCodec, err := getCodecByStringType(lenType.GetString(), refs.builder)
+	if err != nil {
+		return nil, err
+	}
+
+	lenAlign, err := alignOf(parentTypeName, lenType, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &arrayWithLen{
+		elem:     elemCodec,
+		num:      idlArray.Num,
+		elemSize: elemSize,
+		pad:      padding(offset+elemSize*idlArray.Num, lenAlign),
+		length:   lenCodec,
+	}, nil
+}
+
+// padding returns the number of bytes required to align the offset to the alignment.
+func padding(offset, align int) int {
+	if align <= 1 {
+		return 0
+	}
+
+	return (align - offset%align) % align
+}
+
+// padded wraps a codec with zero bytes before and after the encoded value.
+type padded struct {
+	codec  encodings.TypeCodec
+	before int
+	after  int
+}
+
+var _ encodings.TypeCodec = &padded{}
+
+func (p *padded) Encode(value any, into []byte) ([]byte, error) {
+	into = append(into, make([]byte, p.before)...)
+
+	into, err := p.codec.Encode(value, into)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(into, make([]byte, p.after)...), nil
+}
+
+func (p *padded) Decode(encoded []byte) (any, []byte, error) {
+	if len(encoded) < p.before {
+		return nil, nil, fmt.Errorf("%w: not enough bytes to decode padding", types.ErrInvalidEncoding)
+	}
+
+	value, remaining, err := p.codec.Decode(encoded[p.before:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(remaining) < p.after {
+		return nil, nil, fmt.Errorf("%w: not enough bytes to decode padding", types.ErrInvalidEncoding)
+	}
+
+	return value, remaining[p.after:], nil
+}
+
+func (p *padded) GetType() reflect.Type {
+	return p.codec.GetType()
+}
+
+func (p *padded) Size(numItems int) (int, error) {
+	size, err := p.codec.Size(numItems)
+	if err != nil {
+		return 0, err
+	}
+
+	return p.before + size + p.after, nil
+}
+
+func (p *padded) FixedSize() (int, error) {
+	size, err := p.codec.FixedSize()
+	if err != nil {
+		return 0, err
+	}
+
+	return p.before + size + p.after, nil
+}
+
+// arrayWithLen encodes a slice as a fixed array of `num` elements followed by the number of used
+// elements. Unused elements are zeroed.
+type arrayWithLen struct {
+	elem     encodings.TypeCodec
+	num      int
+	elemSize int
+	pad      int
+	length   encodings.TypeCodec
+}
+
+var _ encodings.TypeCodec = &arrayWithLen{}
+
+func (a *arrayWithLen) Encode(value any, into []byte) ([]byte, error) {
+	rValue := reflect.ValueOf(value)
+	if kind := rValue.Kind(); kind != reflect.Array && kind != reflect.Slice {
+		return nil, fmt.Errorf("%w: expected array or slice but got %s", types.ErrNotASlice, kind)
+	}
+
+	numElements := rValue.Len()
+	if numElements > a.num {
+		return nil, fmt.Errorf("%w: expected at most %d elements, got %d", types.ErrSliceWrongLen, a.num, numElements)
+	}
+
+	into, err := encodings.EncodeEach(rValue, into, a.elem)
+	if err != nil {
+		return nil, err
+	}
+
+	into = append(into, make([]byte, (a.num-numElements)*a.elemSize+a.pad)...)
+
+	return a.length.Encode(reflect.ValueOf(numElements).Convert(a.length.GetType()).Interface(), into)
+}
+
+func (a *arrayWithLen) Decode(encoded []byte) (any, []byte, error) {
+	arrayLen := a.num*a.elemSize + a.pad
+	if len(encoded) < arrayLen {
+		return nil, nil, fmt.Errorf("%w: not enough bytes to decode array", types.ErrInvalidEncoding)
+	}
+
+	rawLen, remaining, err := a.length.Decode(encoded[arrayLen:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	numElements := reflect.ValueOf(rawLen).Convert(reflect.TypeOf(uint64(0))).Uint()
+	if numElements > uint64(a.num) { //nolint:gosec // num is the length of an IDL array
+		return nil, nil, fmt.Errorf("%w: array length %d exceeds capacity %d", types.ErrInvalidEncoding, numElements, a.num)
+	}
+
+	rSlice := reflect.MakeSlice(reflect.SliceOf(a.elem.GetType()), int(numElements), int(numElements))
+	if _, _, err = encodings.DecodeEach(encoded, rSlice, int(numElements), a.elem); err != nil {
+		return nil, nil, err
+	}
+
+	return rSlice.Interface(), remaining, nil
+}
+
+func (a *arrayWithLen) GetType() reflect.Type {
+	return reflect.SliceOf(a.elem.GetType())
+}
+
+func (a *arrayWithLen) Size(_ int) (int, error) {
+	return a.FixedSize()
+}
+
+func (a *arrayWithLen) FixedSize() (int, error) {
+	lenSize, err := a.length.FixedSize()
+	if err != nil {
+		return 0, err
+	}
+
+	return a.num*a.elemSize + a.pad + lenSize, nil
+}
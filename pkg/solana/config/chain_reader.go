@@ -11,6 +11,8 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings"
 	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
 	"github.com/smartcontractkit/chainlink-common/pkg/types"
+
+	solanacodec "github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
 )
 
 type ChainReader struct {
@@ -23,10 +25,16 @@ type ChainReaderMethods struct {
 
 type ChainDataReader struct {
 	AnchorIDL string `json:"anchorIDL" toml:"anchorIDL"`
+	// OnChainIDL fetches the IDL from the canonical Anchor IDL account of a program when the
+	// service starts and is used instead of AnchorIDL.
+	OnChainIDL *OnChainIDL `json:"onChainIDL,omitempty" toml:"onChainIDL"`
 	// Encoding defines the type of encoding used for on-chain data. Currently supported
-	// are 'borsh' and 'bincode'.
-	Encoding   EncodingType           `json:"encoding" toml:"encoding"`
-	Procedures []ChainReaderProcedure `json:"procedures" toml:"procedures"`
+	// are 'borsh', 'bincode', and 'bytemuck' for zero-copy accounts.
+	Encoding EncodingType `json:"encoding" toml:"encoding"`
+	// DisableDiscriminator is set for accounts that are not prefixed with an Anchor discriminator
+	// such as the state of native and SPL programs.
+	DisableDiscriminator bool                   `json:"disableDiscriminator,omitempty" toml:"disableDiscriminator"`
+	Procedures           []ChainReaderProcedure `json:"procedures" toml:"procedures"`
 }
 
 type EncodingType int
@@ -34,9 +42,12 @@ type EncodingType int
 const (
 	EncodingTypeBorsh EncodingType = iota
 	EncodingTypeBincode
+	// EncodingTypeBytemuck is used for Anchor zero-copy accounts which are stored with C layout.
+	EncodingTypeBytemuck
 
-	encodingTypeBorshStr   = "borsh"
-	encodingTypeBincodeStr = "bincode"
+	encodingTypeBorshStr    = "borsh"
+	encodingTypeBincodeStr  = "bincode"
+	encodingTypeBytemuckStr = "bytemuck"
 )
 
 func (t EncodingType) MarshalJSON() ([]byte, error) {
@@ -45,6 +56,8 @@ func (t EncodingType) MarshalJSON() ([]byte, error) {
 		return json.Marshal(encodingTypeBorshStr)
 	case EncodingTypeBincode:
 		return json.Marshal(encodingTypeBincodeStr)
+	case EncodingTypeBytemuck:
+		return json.Marshal(encodingTypeBytemuckStr)
 	default:
 		return nil, fmt.Errorf("%w: unrecognized encoding type: %d", types.ErrInvalidConfig, t)
 	}
@@ -62,6 +75,8 @@ func (t *EncodingType) UnmarshalJSON(data []byte) error {
 		*t = EncodingTypeBorsh
 	case encodingTypeBincodeStr:
 		*t = EncodingTypeBincode
+	case encodingTypeBytemuckStr:
+		*t = EncodingTypeBytemuck
 	default:
 		return fmt.Errorf("%w: unrecognized encoding type: %s", types.ErrInvalidConfig, str)
 	}
@@ -69,6 +84,51 @@ func (t *EncodingType) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+type OnChainIDL struct {
+	// ProgramID is the program that owns the IDL account.
+	ProgramID string `json:"programID" toml:"programID"`
+	// Hash optionally pins the hex encoded sha256 hash of the decompressed IDL JSON.
+	Hash string `json:"hash,omitempty" toml:"hash"`
+}
+
+type BalanceType string
+
+const (
+	// BalanceTypeLamports reads the native SOL balance of any account.
+	BalanceTypeLamports BalanceType = "lamports"
+	// BalanceTypeTokenAccount reads the amount and decimals of an SPL token account.
+	BalanceTypeTokenAccount BalanceType = "tokenAccount"
+	// BalanceTypeMintSupply reads the supply and decimals of an SPL token mint.
+	BalanceTypeMintSupply BalanceType = "mintSupply"
+)
+
+type TransmissionsType string
+
+const (
+	// TransmissionsTypeRoundData reads the round given by the RoundID param.
+	TransmissionsTypeRoundData TransmissionsType = "roundData"
+	// TransmissionsTypeRange reads the stored rounds from the FromRoundID to the ToRoundID param (inclusive).
+	TransmissionsTypeRange TransmissionsType = "range"
+)
+
+type ProgramAccountsOpts struct {
+	// DataSize only matches accounts with data of exactly this many bytes.
+	DataSize *uint64 `json:"dataSize,omitempty"`
+	// Memcmp filters match bytes at an offset of the account data. Accounts with a discriminator
+	// are always filtered by it.
+	Memcmp []MemcmpFilter `json:"memcmp,omitempty"`
+}
+
+type MemcmpFilter struct {
+	// Offset in the account data including the discriminator.
+	Offset uint64 `json:"offset"`
+	// Bytes are the base58 encoded bytes to match.
+	Bytes string `json:"bytes,omitempty"`
+	// Param names the read param that provides the bytes to match as an address or byte slice. The
+	// read fails when the param is not provided.
+	Param string `json:"param,omitempty"`
+}
+
 type RPCOpts struct {
 	Encoding   *solana.EncodingType `json:"encoding,omitempty"`
 	Commitment *rpc.CommitmentType  `json:"commitment,omitempty"`
@@ -86,15 +146,41 @@ type chainDataProcedureFields struct {
 	// RPCOpts provides optional configurations for commitment, encoding, and data
 	// slice offsets.
 	RPCOpts *RPCOpts `json:"rpcOpts,omitempty"`
+	// IDLInstruction refers to an instruction defined in the IDL that is simulated instead of reading
+	// IDLAccount. The read params are the instruction arguments and the data the instruction sets with
+	// `set_return_data` is decoded as the result.
+	IDLInstruction string `json:"idlInstruction,omitempty"`
+	// ReturnType is the IDL type of the instruction return data and is required when the IDL does not
+	// declare it.
+	ReturnType *solanacodec.IdlType `json:"returnType,omitempty"`
+	// ProgramID of the simulated instruction. Defaults to the address declared in the IDL.
+	ProgramID string `json:"programID,omitempty"`
+	// FeePayer is an existing account that pays for the simulation. Signatures are not verified.
+	FeePayer string `json:"feePayer,omitempty"`
+	// Accounts provides fixed addresses for instruction accounts by IDL name. The bound address is
+	// used for the first account without a fixed address.
+	Accounts map[string]string `json:"accounts,omitempty"`
+	// InputModifications provides modifiers to convert read params to instruction arguments.
+	InputModifications codec.ModifiersConfig `json:"inputModifications,omitempty"`
+	// ProgramAccounts lists all IDLAccount accounts owned by the bound program address with
+	// getProgramAccounts and returns them as a slice instead of reading a bound account.
+	ProgramAccounts *ProgramAccountsOpts `json:"programAccounts,omitempty"`
+	// Balance reads a balance of the bound address with a built-in RPC method instead of an IDL
+	// account. Supported are 'lamports', 'tokenAccount' and 'mintSupply'.
+	Balance BalanceType `json:"balance,omitempty"`
+	// Transmissions reads rounds of the bound OCR2 transmissions account instead of an IDL account.
+	// Supported are 'roundData' and 'range'.
+	Transmissions TransmissionsType `json:"transmissions,omitempty"`
 }
 
-// BuilderForEncoding returns a builder for the encoding configuration. Defaults to little endian.
+// BuilderForEncoding returns a builder for the encoding configuration. Defaults to little endian which the codec
+// treats as borsh.
 func BuilderForEncoding(eType EncodingType) encodings.Builder {
 	switch eType {
-	case EncodingTypeBorsh:
+	case EncodingTypeBorsh, EncodingTypeBytemuck:
 		return binary.LittleEndian()
 	case EncodingTypeBincode:
-		return binary.BigEndian()
+		return solanacodec.NewBincodeBuilder()
 	default:
 		return binary.LittleEndian()
 	}
@@ -121,8 +121,9 @@ var validChainReaderConfig = config.ChainReader{
 		"OtherContract": {
 			Methods: map[string]config.ChainDataReader{
 				"Method": {
-					AnchorIDL: "test idl 3",
-					Encoding:  config.EncodingTypeBincode,
+					AnchorIDL:            "test idl 3",
+					Encoding:             config.EncodingTypeBincode,
+					DisableDiscriminator: true,
 					Procedures: []config.ChainReaderProcedure{
 						{
 							IDLAccount: testutils.TestStructWithNestedStruct,
@@ -31,6 +31,9 @@ var defaultConfigSet = Chain{
 	BlockHistoryPollPeriod:   config.MustNewDuration(5 * time.Second),
 	ComputeUnitLimitDefault:  ptr(uint32(200_000)), // set to 0 to disable adding compute unit limit
 	EstimateComputeUnitLimit: ptr(false),           // set to false to disable compute unit limit estimation
+
+	// ocr2 transmissions
+	OCR2TransmitBatchWindow: config.MustNewDuration(0), // set to > 0 to batch transmissions of feeds sharing a transmitter
 }
 
 //go:generate mockery --name Config --output ./mocks/ --case=underscore --filename config.go
@@ -39,6 +42,7 @@ type Config interface {
 	ConfirmPollPeriod() time.Duration
 	OCR2CachePollPeriod() time.Duration
 	OCR2CacheTTL() time.Duration
+	OCR2TransmitBatchWindow() time.Duration
 	TxTimeout() time.Duration
 	TxRetryTimeout() time.Duration
 	TxConfirmTimeout() time.Duration
@@ -62,6 +66,7 @@ type Chain struct {
 	ConfirmPollPeriod        *config.Duration
 	OCR2CachePollPeriod      *config.Duration
 	OCR2CacheTTL             *config.Duration
+	OCR2TransmitBatchWindow  *config.Duration
 	TxTimeout                *config.Duration
 	TxRetryTimeout           *config.Duration
 	TxConfirmTimeout         *config.Duration
@@ -91,6 +96,9 @@ func (c *Chain) SetDefaults() {
 	if c.OCR2CacheTTL == nil {
 		c.OCR2CacheTTL = defaultConfigSet.OCR2CacheTTL
 	}
+	if c.OCR2TransmitBatchWindow == nil {
+		c.OCR2TransmitBatchWindow = defaultConfigSet.OCR2TransmitBatchWindow
+	}
 	if c.TxTimeout == nil {
 		c.TxTimeout = defaultConfigSet.TxTimeout
 	}
@@ -136,8 +144,10 @@ func (c *Chain) SetDefaults() {
 }
 
 type Node struct {
-	Name     *string
-	URL      *config.URL
+	Name *string
+	URL  *config.URL
+	// WSURL is the optional websocket endpoint used for account subscriptions.
+	WSURL    *config.URL
 	SendOnly bool
 }
 
@@ -268,6 +268,24 @@ func (_m *Config) OCR2CacheTTL() time.Duration {
 	return r0
 }
 
+// OCR2TransmitBatchWindow provides a mock function with given fields:
+func (_m *Config) OCR2TransmitBatchWindow() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for OCR2TransmitBatchWindow")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // SkipPreflight provides a mock function with given fields:
 func (_m *Config) SkipPreflight() bool {
 	ret := _m.Called()
@@ -105,6 +105,9 @@ func setFromNode(n, f *Node) {
 	if f.URL != nil {
 		n.URL = f.URL
 	}
+	if f.WSURL != nil {
+		n.WSURL = f.WSURL
+	}
 	n.SendOnly = f.SendOnly
 }
 
@@ -146,6 +149,9 @@ func setFromChain(c, f *Chain) {
 	if f.OCR2CacheTTL != nil {
 		c.OCR2CacheTTL = f.OCR2CacheTTL
 	}
+	if f.OCR2TransmitBatchWindow != nil {
+		c.OCR2TransmitBatchWindow = f.OCR2TransmitBatchWindow
+	}
 	if f.TxTimeout != nil {
 		c.TxTimeout = f.TxTimeout
 	}
@@ -223,6 +229,10 @@ func (c *TOMLConfig) OCR2CacheTTL() time.Duration {
 	return c.Chain.OCR2CacheTTL.Duration()
 }
 
+func (c *TOMLConfig) OCR2TransmitBatchWindow() time.Duration {
+	return c.Chain.OCR2TransmitBatchWindow.Duration()
+}
+
 func (c *TOMLConfig) TxTimeout() time.Duration {
 	return c.Chain.TxTimeout.Duration()
 }
@@ -2,27 +2,73 @@ package solana
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
 
 	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
 
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/monitor"
 )
 
+// ErrConfigDigestMismatch is returned if the config digest stored on-chain does not match the digest
+// recomputed from the stored config.
+var ErrConfigDigestMismatch = errors.New("config digest mismatch")
+
 type ConfigTracker struct {
 	stateCache *StateCache
 	reader     client.Reader
+	digester   OffchainConfigDigester
+	chainID    string
+	notify     <-chan struct{}
+
+	lock      sync.RWMutex
+	verifyErr error // result of the latest config verification
+}
+
+func NewConfigTracker(stateCache *StateCache, reader client.Reader, digester OffchainConfigDigester, chainID string) *ConfigTracker {
+	return &ConfigTracker{
+		stateCache: stateCache,
+		reader:     reader,
+		digester:   digester,
+		chainID:    chainID,
+		notify:     stateCache.OnChange(configChanged),
+	}
+}
+
+func (c *ConfigTracker) Name() string {
+	return c.stateCache.Name() + ".ConfigTracker"
+}
+
+// HealthReport reports a config whose digest does not match the on-chain digest.
+func (c *ConfigTracker) HealthReport() map[string]error {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return map[string]error{c.Name(): c.verifyErr}
 }
 
+// Notify signals when the state cache stores a new config, which is pushed by the account subscription or
+// detected while polling. libocr still polls for config changes if there is no signal.
 func (c *ConfigTracker) Notify() <-chan struct{} {
-	return nil // not using websocket, config changes will be handled by polling in libocr
+	return c.notify
+}
+
+// configChanged reports whether a new config was set between the states.
+func configChanged(prev, next State) bool {
+	return prev.Config.LatestConfigDigest != next.Config.LatestConfigDigest || prev.Config.ConfigCount != next.Config.ConfigCount
 }
 
 // LatestConfigDetails returns information about the latest configuration,
 // but not the configuration itself.
+// A config that fails verification is not reported.
 func (c *ConfigTracker) LatestConfigDetails(ctx context.Context) (changedInBlock uint64, configDigest types.ConfigDigest, err error) {
-	state, err := c.stateCache.Read()
-	return state.Config.LatestConfigBlockNumber, state.Config.LatestConfigDigest, err
+	state, _, err := c.latestConfig(ctx)
+	if err != nil {
+		return 0, types.ConfigDigest{}, err
+	}
+	return state.Config.LatestConfigBlockNumber, state.Config.LatestConfigDigest, nil
 }
 
 func ConfigFromState(ctx context.Context, state State) (types.ContractConfig, error) {
@@ -64,13 +110,51 @@ func ConfigFromState(ctx context.Context, state State) (types.ContractConfig, er
 	}, nil
 }
 
-// LatestConfig returns the latest configuration.
+// VerifyConfig checks that the config digest stored on-chain matches the digest recomputed from the stored
+// config. An unconfigured contract has no digest to verify.
+func VerifyConfig(ctx context.Context, digester types.OffchainConfigDigester, config types.ContractConfig) error {
+	if config.ConfigDigest == (types.ConfigDigest{}) {
+		return nil
+	}
+
+	digest, err := digester.ConfigDigest(ctx, config)
+	if err != nil {
+		return fmt.Errorf("failed to recompute config digest: %w", err)
+	}
+	if digest != config.ConfigDigest {
+		return fmt.Errorf("%w: on-chain digest %s, recomputed digest %s", ErrConfigDigestMismatch, config.ConfigDigest, digest)
+	}
+	return nil
+}
+
+// LatestConfig returns the latest configuration. A config that fails verification is not returned.
 func (c *ConfigTracker) LatestConfig(ctx context.Context, changedInBlock uint64) (types.ContractConfig, error) {
+	_, config, err := c.latestConfig(ctx)
+	return config, err
+}
+
+// latestConfig reads and verifies the config of the cached state.
+func (c *ConfigTracker) latestConfig(ctx context.Context) (State, types.ContractConfig, error) {
 	state, err := c.stateCache.Read()
 	if err != nil {
-		return types.ContractConfig{}, err
+		return State{}, types.ContractConfig{}, err
 	}
-	return ConfigFromState(ctx, state)
+
+	config, err := ConfigFromState(ctx, state)
+	if err != nil {
+		return State{}, types.ContractConfig{}, err
+	}
+
+	err = VerifyConfig(ctx, c.digester, config)
+	c.lock.Lock()
+	c.verifyErr = err
+	c.lock.Unlock()
+	monitor.SetConfigDigestMismatch(c.chainID, c.digester.StateID.String(), errors.Is(err, ErrConfigDigestMismatch))
+	if err != nil {
+		return State{}, types.ContractConfig{}, err
+	}
+
+	return state, config, nil
 }
 
 // LatestBlockHeight returns the height of the most recent block in the chain.
@@ -6,8 +6,17 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
 )
 
 func TestLatestBlockHeight(t *testing.T) {
@@ -26,3 +35,88 @@ func TestLatestBlockHeight(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, h > 0)
 }
+
+func TestConfigTracker_Notify(t *testing.T) {
+	ctx := tests.Context(t)
+
+	var state State
+	getter := func(context.Context) (State, uint64, error) { return state, 0, nil }
+	stateCache := &StateCache{client.NewCache("test", solana.PublicKey{}, "test-chain-id", config.NewDefault(), getter, logger.Test(t))}
+	tracker := NewConfigTracker(stateCache, nil, OffchainConfigDigester{}, "test-chain-id")
+
+	notified := func() bool {
+		select {
+		case <-tracker.Notify():
+			return true
+		default:
+			return false
+		}
+	}
+
+	require.NoError(t, stateCache.Fetch(ctx))
+	assert.False(t, notified())
+
+	// other state changes are not signalled
+	state.Config.Epoch = 1
+	require.NoError(t, stateCache.Fetch(ctx))
+	assert.False(t, notified())
+
+	state.Config.LatestConfigDigest = [32]byte{1}
+	require.NoError(t, stateCache.Fetch(ctx))
+	assert.True(t, notified())
+
+	// signals are coalesced
+	state.Config.ConfigCount = 2
+	require.NoError(t, stateCache.Fetch(ctx))
+	state.Config.ConfigCount = 3
+	require.NoError(t, stateCache.Fetch(ctx))
+	assert.True(t, notified())
+	assert.False(t, notified())
+}
+
+func TestConfigTracker_VerifyConfig(t *testing.T) {
+	ctx := tests.Context(t)
+
+	var state State
+	require.NoError(t, bin.NewBorshDecoder(mockState.Raw).Decode(&state))
+	getter := func(context.Context) (State, uint64, error) { return state, 0, nil }
+
+	setup := func(t *testing.T, stateID string) *ConfigTracker {
+		stateCache := &StateCache{client.NewCache("test", solana.PublicKey{}, "test-chain-id", config.NewDefault(), getter, logger.Test(t))}
+		require.NoError(t, stateCache.Fetch(ctx))
+		digester := OffchainConfigDigester{
+			ProgramID: solana.MustPublicKeyFromBase58("HW3ipKzeeduJq6f1NqRCw4doknMeWkfrM4WxobtG3o5v"),
+			StateID:   solana.MustPublicKeyFromBase58(stateID),
+		}
+		return NewConfigTracker(stateCache, nil, digester, "test-chain-id")
+	}
+
+	t.Run("matching digest", func(t *testing.T) {
+		tracker := setup(t, "ES64UceMzVRQ1t9j7VZKHi7A2cJ4seVmbKNmbtFZUiYz")
+
+		_, digest, err := tracker.LatestConfigDetails(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, mockState.ConfigDigestHex, digest.Hex())
+
+		cfg, err := tracker.LatestConfig(ctx, 0)
+		require.NoError(t, err)
+		assert.Equal(t, digest, cfg.ConfigDigest)
+		assert.NoError(t, tracker.HealthReport()[tracker.Name()])
+	})
+
+	t.Run("mismatched digest", func(t *testing.T) {
+		tracker := setup(t, solana.NewWallet().PublicKey().String())
+
+		_, _, err := tracker.LatestConfigDetails(ctx)
+		require.ErrorIs(t, err, ErrConfigDigestMismatch)
+
+		_, err = tracker.LatestConfig(ctx, 0)
+		require.ErrorIs(t, err, ErrConfigDigestMismatch)
+		assert.ErrorIs(t, tracker.HealthReport()[tracker.Name()], ErrConfigDigestMismatch)
+	})
+
+	t.Run("unconfigured contract", func(t *testing.T) {
+		digester := OffchainConfigDigester{ProgramID: solana.NewWallet().PublicKey()}
+		require.NoError(t, VerifyConfig(ctx, digester, types.ContractConfig{}))
+	})
+}
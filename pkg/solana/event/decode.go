@@ -0,0 +1,150 @@
+package event
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"regexp"
+	"sync"
+
+	bin "github.com/gagliardetto/binary"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/idl"
+)
+
+var programInvocation = regexp.MustCompile(`^Program\s([a-zA-Z0-9]+)?\sinvoke\s\[\d\]$`)
+var programFinished = regexp.MustCompile(`^Program\s([a-zA-Z0-9]+)?\s(?:success|error)$`)
+var programLogEvent = regexp.MustCompile(`^Program\s(?:log|data):\s([+/0-9A-Za-z]+={0,2})?$`)
+
+func ExtractEvents(logs []string, programIDBase58 string) []string {
+	invocationStack := []string{}
+	output := []string{}
+	for _, log := range logs {
+		if matches := programInvocation.FindStringSubmatch(log); matches != nil {
+			invokedProgramID := matches[1]
+			invocationStack = append(invocationStack, invokedProgramID)
+			continue
+		}
+		if matches := programLogEvent.FindStringSubmatch(log); matches != nil {
+			currentProgramID := invocationStack[len(invocationStack)-1]
+			if programIDBase58 == currentProgramID {
+				output = append(output, matches[1])
+			}
+			continue
+		}
+		if matches := programFinished.FindStringSubmatch(log); matches != nil {
+			if len(invocationStack) == 0 {
+				break // incorrect execution trace.
+			}
+			finishedProgramID := matches[1]
+			if invocationStack[len(invocationStack)-1] == finishedProgramID {
+				invocationStack = invocationStack[:len(invocationStack)-1]
+			}
+		}
+	}
+	return output
+}
+
+// ocr2EventCodec decodes the events of the OCR2 program from its IDL.
+var ocr2EventCodec = sync.OnceValues(func() (*codec.IDLEventCodec, error) {
+	var ocr2IDL codec.IDL
+	if err := json.Unmarshal([]byte(idl.OCR2), &ocr2IDL); err != nil {
+		return nil, fmt.Errorf("failed to parse OCR2 IDL: %w", err)
+	}
+
+	return codec.NewIDLEventCodec(ocr2IDL, binary.LittleEndian())
+})
+
+// eventTypes are the Go types that OCR2 events are decoded into by event name.
+var eventTypes = map[string]func() any{
+	"SetConfig":       func() any { return &SetConfig{} },
+	"SetBilling":      func() any { return &SetBilling{} },
+	"RoundRequested":  func() any { return &RoundRequested{} },
+	"NewTransmission": func() any { return &newTransmission{} },
+}
+
+// newTransmission is NewTransmission with the i128 answer as decoded by the event codec.
+type newTransmission struct {
+	RoundID               uint32
+	ConfigDigest          [32]uint8
+	Answer                *big.Int
+	Transmitter           uint8
+	ObservationsTimestamp uint32
+	ObserverCount         uint8
+	Observers             [19]uint8
+	JuelsPerLamport       uint64
+	ReimbursementGJuels   uint64
+}
+
+var maxUint64 = new(big.Int).SetUint64(math.MaxUint64)
+
+func (n newTransmission) event() NewTransmission {
+	// And keeps the two's complement representation of negative answers
+	answer := new(big.Int).And(n.Answer, new(big.Int).Lsh(maxUint64, 64))
+	return NewTransmission{
+		RoundID:      n.RoundID,
+		ConfigDigest: n.ConfigDigest,
+		Answer: bin.Int128{
+			Lo: new(big.Int).And(n.Answer, maxUint64).Uint64(),
+			Hi: answer.Rsh(answer, 64).Uint64(),
+		},
+		Transmitter:           n.Transmitter,
+		ObservationsTimestamp: n.ObservationsTimestamp,
+		ObserverCount:         n.ObserverCount,
+		Observers:             n.Observers,
+		JuelsPerLamport:       n.JuelsPerLamport,
+		ReimbursementGJuels:   n.ReimbursementGJuels,
+	}
+}
+
+// Decode extracts an event from the the encoded event given as a string.
+func Decode(base64Encoded string) (interface{}, error) {
+	buf, err := base64.StdEncoding.DecodeString(base64Encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode event '%s' from base64: %w", base64Encoded, err)
+	}
+
+	eventCodec, err := ocr2EventCodec()
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := eventCodec.EventName(buf)
+	if err != nil {
+		return nil, fmt.Errorf("Unrecognised event: %w", err)
+	}
+
+	newEvent, ok := eventTypes[name]
+	if !ok {
+		return nil, fmt.Errorf("Unsupported event %s", name)
+	}
+
+	event := newEvent()
+	if err = eventCodec.Decode(context.Background(), buf, event, name); err != nil {
+		return nil, fmt.Errorf("failed to decode event '%v' of type '%T': %w", buf, event, err)
+	}
+
+	if transmission, ok := event.(*newTransmission); ok {
+		return transmission.event(), nil
+	}
+	return reflect.ValueOf(event).Elem().Interface(), nil
+}
+
+func DecodeMultiple(base64EncodedEvents []string) ([]interface{}, error) {
+	events := []interface{}{}
+	for _, encoded := range base64EncodedEvents {
+		event, err := Decode(encoded)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
@@ -1,6 +1,7 @@
 package event
 
 import (
+	"encoding/base64"
 	"encoding/binary"
 	"fmt"
 	"testing"
@@ -199,4 +200,25 @@ func TestDecode(t *testing.T) {
 	decoded, err := Decode(encoded)
 	require.NoError(t, err)
 	require.Equal(t, expected, decoded)
+
+	t.Run("negative answer matches UnmarshalBinary", func(t *testing.T) {
+		buf, err := base64.StdEncoding.DecodeString(encoded)
+		require.NoError(t, err)
+		require.Equal(t, NewTransmissionDiscriminator, buf[:discriminatorLength])
+		answerOffset := discriminatorLength + 4 + 32
+		for i := 0; i < 16; i++ {
+			buf[answerOffset+i] = 0xff // -1
+		}
+		buf[answerOffset] = 0xfb // -5
+
+		var unmarshaled NewTransmission
+		require.NoError(t, unmarshaled.UnmarshalBinary(buf[discriminatorLength:]))
+		require.Equal(t, "-5", unmarshaled.Answer.BigInt().String())
+
+		decoded, err := Decode(base64.StdEncoding.EncodeToString(buf))
+		require.NoError(t, err)
+		require.Equal(t, unmarshaled.Answer.BigInt(), decoded.(NewTransmission).Answer.BigInt())
+		require.Equal(t, unmarshaled.Answer.Lo, decoded.(NewTransmission).Answer.Lo)
+		require.Equal(t, unmarshaled.Answer.Hi, decoded.(NewTransmission).Answer.Hi)
+	})
 }
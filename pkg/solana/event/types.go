@@ -1,10 +1,13 @@
 package event
 
 import (
+	"crypto/sha256"
+
 	bin "github.com/gagliardetto/binary"
 	"github.com/gagliardetto/solana-go"
 )
 
+// Discriminators of the OCR2 program events. Decode resolves events by name with the IDL event codec.
 var (
 	SetConfigDiscriminator       = getDiscriminator("event:SetConfig")
 	SetBillingDiscriminator      = getDiscriminator("event:SetBilling")
@@ -62,3 +65,10 @@ type NewTransmission struct {
 func (n *NewTransmission) UnmarshalBinary(data []byte) error {
 	return bin.NewBinDecoder(data).Decode(n)
 }
+
+const discriminatorLength = 8
+
+func getDiscriminator(prefix string) []byte {
+	hash := sha256.Sum256([]byte(prefix))
+	return hash[:discriminatorLength]
+}
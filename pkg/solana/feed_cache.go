@@ -0,0 +1,221 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+)
+
+// FeedSnapshot is the state and the latest transmission of a feed read at the same slot.
+type FeedSnapshot struct {
+	State  State
+	Answer Answer
+	Slot   uint64
+}
+
+// FeedReader reads the state and transmissions accounts of a feed.
+type FeedReader interface {
+	client.AccountReader
+	client.MultipleAccountsReader
+}
+
+// FeedCache provides the state and the latest transmission of a feed, and runs the state cache of the
+// feed. With account subscriptions, the subscribed state cache is combined with a subscribed transmissions
+// cache. Otherwise both accounts are polled together and the state is stored in the state cache, which
+// does not poll on its own.
+type FeedCache struct {
+	stateCache *StateCache
+	reader     FeedReader
+	commitment rpc.CommitmentType
+
+	transmissions *TransmissionsCache         // subscribed
+	snapshots     *client.Cache[FeedSnapshot] // polled
+
+	// latest snapshot read for subscribed caches that were updated at different slots
+	refetchedLock sync.Mutex
+	refetched     *refetchedSnapshot
+}
+
+type refetchedSnapshot struct {
+	stateSlot, answerSlot uint64
+	snapshot              FeedSnapshot
+}
+
+func NewFeedCache(stateCache *StateCache, transmissionsID solana.PublicKey, chainID string, cfg config.Config, reader FeedReader, lggr logger.Logger) *FeedCache {
+	name := "ocr2_median_feed"
+	getter := func(ctx context.Context) (FeedSnapshot, uint64, error) {
+		snapshot, err := GetFeedSnapshot(ctx, reader, stateCache.Account, transmissionsID, cfg.Commitment())
+		if err == nil {
+			stateCache.Store(snapshot.State, snapshot.Slot)
+		}
+		return snapshot, snapshot.Slot, err
+	}
+	return &FeedCache{
+		stateCache: stateCache,
+		reader:     reader,
+		commitment: cfg.Commitment(),
+		snapshots:  client.NewCache(name, stateCache.Account, chainID, cfg, getter, logger.With(lggr, "cache", name)),
+	}
+}
+
+// NewSubscribedFeedCache creates a feed cache for a subscribed state cache. The reader is used to read both
+// accounts together when their subscriptions were updated at different slots.
+func NewSubscribedFeedCache(stateCache *StateCache, transmissionsID solana.PublicKey, chainID string, cfg config.Config, subscriptions *client.AccountSubscriptions, reader FeedReader, lggr logger.Logger) *FeedCache {
+	return &FeedCache{
+		stateCache:    stateCache,
+		reader:        reader,
+		commitment:    cfg.Commitment(),
+		transmissions: NewSubscribedTransmissionsCache(transmissionsID, chainID, cfg, subscriptions, lggr),
+	}
+}
+
+func (c *FeedCache) Start(ctx context.Context) error {
+	if c.snapshots != nil {
+		return c.snapshots.Start(ctx)
+	}
+	var ms services.MultiStart
+	return ms.Start(ctx, c.stateCache, c.transmissions)
+}
+
+func (c *FeedCache) Close() error {
+	if c.snapshots != nil {
+		return c.snapshots.Close()
+	}
+	return services.CloseAll(c.transmissions, c.stateCache)
+}
+
+func (c *FeedCache) Name() string {
+	return c.stateCache.Name() + ".FeedCache"
+}
+
+// HealthReport reports caches that are not running or whose data is stale.
+func (c *FeedCache) HealthReport() map[string]error {
+	var err error
+	if c.snapshots != nil {
+		err = cacheHealth(c.snapshots)
+	} else {
+		err = errors.Join(cacheHealth(c.stateCache.Cache), cacheHealth(c.transmissions.Cache))
+	}
+	return map[string]error{c.Name(): err}
+}
+
+func cacheHealth[R any](cache *client.Cache[R]) error {
+	if err := cache.Healthy(); err != nil {
+		return err
+	}
+	_, err := cache.Read()
+	return err
+}
+
+// Read returns the latest snapshot, in which the state and the latest transmission are consistent. The
+// state and the transmission of subscribed caches are updated separately, so both accounts are read
+// together if their updates are from different slots.
+func (c *FeedCache) Read(ctx context.Context) (FeedSnapshot, error) {
+	if c.snapshots != nil {
+		return c.snapshots.Read()
+	}
+
+	state, stateSlot, stateErr := c.stateCache.ReadWithSlot()
+	answer, answerSlot, answerErr := c.transmissions.ReadWithSlot()
+	if err := errors.Join(stateErr, answerErr); err != nil {
+		return FeedSnapshot{}, err
+	}
+
+	// a transmission updates both accounts in the same slot
+	if stateSlot == answerSlot {
+		return FeedSnapshot{State: state, Answer: answer, Slot: stateSlot}, nil
+	}
+
+	c.refetchedLock.Lock()
+	defer c.refetchedLock.Unlock()
+
+	if c.refetched != nil && c.refetched.stateSlot == stateSlot && c.refetched.answerSlot == answerSlot {
+		return c.refetched.snapshot, nil
+	}
+
+	snapshot, err := GetFeedSnapshot(client.WithMinContextSlot(ctx, max(stateSlot, answerSlot)), c.reader, c.stateCache.Account, c.transmissions.Account, c.commitment)
+	if err != nil {
+		return FeedSnapshot{}, err
+	}
+
+	c.refetched = &refetchedSnapshot{stateSlot: stateSlot, answerSlot: answerSlot, snapshot: snapshot}
+	return snapshot, nil
+}
+
+// GetFeedSnapshot reads the state account and the header of the transmissions account with a single request,
+// then the latest transmission of the header at the same or a later slot. The latest transmission is only
+// overwritten once the live ring buffer wraps, so it is consistent with the state.
+func GetFeedSnapshot(ctx context.Context, reader FeedReader, stateID, transmissionsID solana.PublicKey, commitment rpc.CommitmentType) (FeedSnapshot, error) {
+	// the state is read in full, and the same slice of the transmissions account contains its header
+	offset, length := uint64(0), StateLen
+	res, err := reader.GetMultipleAccountsWithOpts(ctx, []solana.PublicKey{stateID, transmissionsID}, &rpc.GetMultipleAccountsOpts{
+		Encoding:   "base64",
+		Commitment: commitment,
+		DataSlice: &rpc.DataSlice{
+			Offset: &offset,
+			Length: &length,
+		},
+		MinContextSlot: client.MinContextSlot(ctx),
+	})
+	if err != nil {
+		return FeedSnapshot{}, fmt.Errorf("error on rpc.GetMultipleAccounts [state, transmissions]: %w", err)
+	}
+
+	// check for nil pointers
+	if res == nil || len(res.Value) != 2 {
+		return FeedSnapshot{}, errors.New("unexpected result returned in GetFeedSnapshot.GetMultipleAccountsWithOpts")
+	}
+	for i, account := range []solana.PublicKey{stateID, transmissionsID} {
+		if res.Value[i] == nil || res.Value[i].Data == nil {
+			return FeedSnapshot{}, fmt.Errorf("nil pointer returned in GetFeedSnapshot.GetMultipleAccountsWithOpts for account '%s'", account)
+		}
+	}
+
+	state, err := DecodeState(res.Value[0].Data.GetBinary())
+	if err != nil {
+		return FeedSnapshot{}, err
+	}
+
+	if state.Transmissions != transmissionsID {
+		return FeedSnapshot{}, fmt.Errorf("state account '%s' stores transmissions in '%s', not '%s'", stateID, state.Transmissions, transmissionsID)
+	}
+
+	slot := res.RPCContext.Context.Slot
+	data := res.Value[1].Data.GetBinary()
+
+	headerEnd := AccountDiscriminatorLen + TransmissionsHeaderLen
+	if uint64(len(data)) < headerEnd {
+		return FeedSnapshot{}, fmt.Errorf("transmissions account data too short for header: %d bytes", len(data))
+	}
+
+	header, err := decodeTransmissionsHeader(data[AccountDiscriminatorLen:headerEnd])
+	if err != nil {
+		return FeedSnapshot{}, err
+	}
+
+	var answer Answer
+	if transmissionOffset := latestTransmissionOffset(header); uint64(len(data)) >= transmissionOffset+TransmissionLen {
+		answer, err = decodeTransmission(data[transmissionOffset : transmissionOffset+TransmissionLen])
+	} else {
+		answer, _, err = getTransmission(ctx, reader, transmissionsID, transmissionOffset, commitment, slot)
+	}
+	if err != nil {
+		return FeedSnapshot{}, err
+	}
+
+	return FeedSnapshot{
+		State:  state,
+		Answer: answer,
+		Slot:   slot,
+	}, nil
+}
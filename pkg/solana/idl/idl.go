@@ -0,0 +1,12 @@
+// Package idl embeds the IDLs of the on-chain programs. They are copied from the anchor build output by
+// the cp_gauntlet_idl make target.
+package idl
+
+import (
+	_ "embed"
+)
+
+// OCR2 is the IDL of the OCR2 program.
+//
+//go:embed ocr_2.json
+var OCR2 string
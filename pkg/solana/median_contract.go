@@ -2,15 +2,47 @@ package solana
 
 import (
 	"context"
+	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/event"
 )
 
+// roundRequestedSignaturesLimit is the maximum number of recent state account transactions that are searched
+// for RoundRequested events.
+const roundRequestedSignaturesLimit = 100
+
+// roundRequestedTransactionsLimit is the maximum number of transactions fetched by a single LatestRoundRequested call.
+const roundRequestedTransactionsLimit = 10
+
 type MedianContract struct {
-	stateCache         *StateCache
-	transmissionsCache *TransmissionsCache
+	stateCache *StateCache
+	feedCache  *FeedCache
+	programID  solana.PublicKey
+	stateID    solana.PublicKey
+	reader     client.Reader
+	lggr       logger.Logger
+
+	// latest RoundRequested search, transactions up to its signature are not fetched again
+	roundRequestedLock sync.Mutex
+	roundRequested     *roundRequestedSearch
+}
+
+// roundRequestedSearch is the result of searching the state account transactions up to the newest signature.
+type roundRequestedSearch struct {
+	signature solana.Signature
+	found     bool // false if no RoundRequested event was emitted after the latest NewTransmission event
+	event     event.RoundRequested
+	blockTime *solana.UnixTimeSeconds
 }
 
 func (c *MedianContract) LatestTransmissionDetails(
@@ -23,20 +55,17 @@ func (c *MedianContract) LatestTransmissionDetails(
 	latestTimestamp time.Time,
 	err error,
 ) {
-	state, err := c.stateCache.Read()
-	if err != nil {
-		return configDigest, epoch, round, latestAnswer, latestTimestamp, err
-	}
-	answer, err := c.transmissionsCache.Read()
+	// state and answer are consistent, see FeedCache.Read
+	snapshot, err := c.feedCache.Read(ctx)
 	if err != nil {
 		return configDigest, epoch, round, latestAnswer, latestTimestamp, err
 	}
 
-	configDigest = state.Config.LatestConfigDigest
-	epoch = state.Config.Epoch
-	round = state.Config.Round
-	latestAnswer = answer.Data
-	latestTimestamp = time.Unix(int64(answer.Timestamp), 0)
+	configDigest = snapshot.State.Config.LatestConfigDigest
+	epoch = snapshot.State.Config.Epoch
+	round = snapshot.State.Config.Round
+	latestAnswer = snapshot.Answer.Data
+	latestTimestamp = time.Unix(int64(snapshot.Answer.Timestamp), 0)
 	return configDigest, epoch, round, latestAnswer, latestTimestamp, nil
 }
 
@@ -60,6 +89,112 @@ func (c *MedianContract) LatestRoundRequested(
 	round uint8,
 	err error,
 ) {
-	state, err := c.stateCache.Read()
-	return state.Config.LatestConfigDigest, 0, 0, err
+	c.roundRequestedLock.Lock()
+	defer c.roundRequestedLock.Unlock()
+
+	// RequestNewRound and Transmit both use the state account, so its transactions contain all events
+	limit := roundRequestedSignaturesLimit
+	opts := &rpc.GetSignaturesForAddressOpts{
+		Commitment: rpc.CommitmentConfirmed,
+		Limit:      &limit,
+	}
+	if c.roundRequested != nil {
+		opts.Until = c.roundRequested.signature
+	}
+	sigs, err := c.reader.GetSignaturesForAddressWithOpts(ctx, c.stateID, opts)
+	if err != nil {
+		return configDigest, epoch, round, fmt.Errorf("failed to fetch transactions for state account: %w", err)
+	}
+
+	cutoff := time.Now().Add(-lookback)
+	search, complete := c.searchRoundRequested(ctx, sigs, cutoff)
+	if len(sigs) > 0 && complete {
+		c.roundRequested = &search
+	}
+
+	if search.found && (search.blockTime == nil || !search.blockTime.Time().Before(cutoff)) {
+		return search.event.ConfigDigest, search.event.Epoch, search.event.Round, nil
+	}
+	return configDigest, epoch, round, nil
+}
+
+// searchRoundRequested searches the transactions of the signatures for the latest RoundRequested or
+// NewTransmission event and continues with the previous search if neither is found. Transactions that
+// fail to be fetched are skipped, the search is incomplete then.
+func (c *MedianContract) searchRoundRequested(ctx context.Context, sigs []*rpc.TransactionSignature, cutoff time.Time) (search roundRequestedSearch, complete bool) {
+	if len(sigs) == 0 {
+		if c.roundRequested != nil {
+			return *c.roundRequested, true
+		}
+		return search, false
+	}
+
+	search.signature = sigs[0].Signature
+	complete = true
+	maxVersion := uint64(0)
+	fetched := 0
+
+	// signatures are ordered from the newest to the oldest transaction
+	for _, sig := range sigs {
+		if sig.BlockTime != nil && sig.BlockTime.Time().Before(cutoff) {
+			// older events are outside of the lookback
+			return search, complete
+		}
+
+		if sig.Err != nil {
+			continue
+		}
+
+		if fetched == roundRequestedTransactionsLimit {
+			return search, false
+		}
+		fetched++
+
+		tx, err := c.reader.GetTransaction(ctx, sig.Signature, &rpc.GetTransactionOpts{
+			Commitment:                     rpc.CommitmentConfirmed,
+			MaxSupportedTransactionVersion: &maxVersion,
+		})
+		if err != nil {
+			c.lggr.Warnw("Failed to fetch transaction, skipping it", "signature", sig.Signature, "err", err)
+			complete = false
+			continue
+		}
+
+		if tx == nil || tx.Meta == nil {
+			continue
+		}
+
+		if e, ok := c.latestRoundEvent(tx.Meta.LogMessages, sig.Signature); ok {
+			if requested, ok := e.(event.RoundRequested); ok {
+				search.found, search.event, search.blockTime = true, requested, sig.BlockTime
+			}
+			return search, complete
+		}
+	}
+
+	// all signatures since the previous search were searched
+	if len(sigs) < roundRequestedSignaturesLimit && c.roundRequested != nil && complete {
+		search.found, search.event, search.blockTime = c.roundRequested.found, c.roundRequested.event, c.roundRequested.blockTime
+	}
+	return search, complete
+}
+
+// latestRoundEvent returns the newest RoundRequested or NewTransmission event in the logs of a transaction.
+func (c *MedianContract) latestRoundEvent(logs []string, sig solana.Signature) (any, bool) {
+	// events of a transaction are in emission order, so the newest one is last
+	events := event.ExtractEvents(logs, c.programID.String())
+	for i := len(events) - 1; i >= 0; i-- {
+		decoded, err := event.Decode(events[i])
+		if err != nil {
+			c.lggr.Debugw("Failed to decode OCR2 event", "signature", sig, "err", err)
+			continue
+		}
+
+		switch decoded.(type) {
+		case event.RoundRequested, event.NewTransmission:
+			// rounds requested before the latest transmission are no longer relevant
+			return decoded, true
+		}
+	}
+	return nil, false
 }
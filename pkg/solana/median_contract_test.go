@@ -0,0 +1,207 @@
+package solana
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	clientmocks "github.com/smartcontractkit/chainlink-solana/pkg/solana/client/mocks"
+)
+
+// testNewTransmissionEvent is an encoded NewTransmission event of the OCR2 program.
+const testNewTransmissionEvent = "gjbLTR5rT6iaSQcAAAMQumV5CqMwMWjU5bBudJS4G7Kr1YGm1javi5Tpf4Y3dOLMJAAAAAAAAAAAAAAAAigtRmIQCAEOCQ8EBgcFAwoLDA0CAAAAAADKmjsAAAAAiBMAAAAAAAA="
+
+func testRoundRequestedEvent(digest [32]byte, epoch uint32, round uint8) string {
+	discriminator := sha256.Sum256([]byte("event:RoundRequested"))
+
+	var buf bytes.Buffer
+	buf.Write(discriminator[:8])
+	buf.Write(digest[:])
+	buf.Write(solana.PublicKey{}.Bytes())
+	_ = binary.Write(&buf, binary.LittleEndian, epoch)
+	buf.WriteByte(round)
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+func testEventLogs(programID solana.PublicKey, events ...string) []string {
+	logs := []string{"Program " + programID.String() + " invoke [1]"}
+	for _, e := range events {
+		logs = append(logs, "Program data: "+e)
+	}
+	return append(logs, "Program "+programID.String()+" success")
+}
+
+func TestMedianContract_LatestRoundRequested(t *testing.T) {
+	programID := solana.NewWallet().PublicKey()
+	stateID := solana.NewWallet().PublicKey()
+	digest := [32]byte{1, 2, 3}
+
+	now := solana.UnixTimeSeconds(time.Now().Unix())
+	old := solana.UnixTimeSeconds(time.Now().Add(-time.Hour).Unix())
+
+	setup := func(t *testing.T, sigs []*rpc.TransactionSignature, logs map[solana.Signature][]string) *MedianContract {
+		reader := clientmocks.NewReaderWriter(t)
+		reader.On("GetSignaturesForAddressWithOpts", mock.Anything, stateID, mock.Anything).Return(sigs, nil).Once()
+		for sig, txLogs := range logs {
+			reader.On("GetTransaction", mock.Anything, sig, mock.Anything).Return(&rpc.GetTransactionResult{
+				Meta: &rpc.TransactionMeta{LogMessages: txLogs},
+			}, nil).Once()
+		}
+
+		return &MedianContract{programID: programID, stateID: stateID, reader: reader, lggr: logger.Test(t)}
+	}
+
+	t.Run("returns the latest requested round", func(t *testing.T) {
+		sigs := []*rpc.TransactionSignature{
+			{Signature: solana.Signature{1}, BlockTime: &now, Err: "failed"},
+			{Signature: solana.Signature{2}, BlockTime: &now},
+		}
+		contract := setup(t, sigs, map[solana.Signature][]string{
+			{2}: testEventLogs(programID, testRoundRequestedEvent([32]byte{}, 1, 1), testRoundRequestedEvent(digest, 3, 4)),
+		})
+
+		configDigest, epoch, round, err := contract.LatestRoundRequested(tests.Context(t), time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, digest[:], configDigest[:])
+		assert.Equal(t, uint32(3), epoch)
+		assert.Equal(t, uint8(4), round)
+	})
+
+	t.Run("ignores rounds requested before the latest transmission", func(t *testing.T) {
+		sigs := []*rpc.TransactionSignature{
+			{Signature: solana.Signature{1}, BlockTime: &now},
+			{Signature: solana.Signature{2}, BlockTime: &now},
+		}
+		contract := setup(t, sigs, map[solana.Signature][]string{
+			{1}: testEventLogs(programID, testNewTransmissionEvent),
+		})
+
+		configDigest, epoch, round, err := contract.LatestRoundRequested(tests.Context(t), time.Minute)
+		require.NoError(t, err)
+		assert.Zero(t, configDigest)
+		assert.Zero(t, epoch)
+		assert.Zero(t, round)
+	})
+
+	t.Run("ignores events of other programs and outside of the lookback", func(t *testing.T) {
+		sigs := []*rpc.TransactionSignature{
+			{Signature: solana.Signature{1}, BlockTime: &now},
+			{Signature: solana.Signature{2}, BlockTime: &old},
+		}
+		contract := setup(t, sigs, map[solana.Signature][]string{
+			{1}: testEventLogs(solana.NewWallet().PublicKey(), testRoundRequestedEvent(digest, 3, 4)),
+		})
+
+		configDigest, epoch, round, err := contract.LatestRoundRequested(tests.Context(t), time.Minute)
+		require.NoError(t, err)
+		assert.Zero(t, configDigest)
+		assert.Zero(t, epoch)
+		assert.Zero(t, round)
+	})
+
+	t.Run("skips transactions that fail to be fetched", func(t *testing.T) {
+		sigs := []*rpc.TransactionSignature{
+			{Signature: solana.Signature{1}, BlockTime: &now},
+			{Signature: solana.Signature{2}, BlockTime: &now},
+		}
+		contract := setup(t, sigs, map[solana.Signature][]string{
+			{2}: testEventLogs(programID, testRoundRequestedEvent(digest, 3, 4)),
+		})
+		reader := contract.reader.(*clientmocks.ReaderWriter)
+		reader.On("GetTransaction", mock.Anything, solana.Signature{1}, mock.Anything).Return(nil, errors.New("not found")).Once()
+
+		configDigest, epoch, round, err := contract.LatestRoundRequested(tests.Context(t), time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, digest[:], configDigest[:])
+		assert.Equal(t, uint32(3), epoch)
+		assert.Equal(t, uint8(4), round)
+
+		// the incomplete search is not cached
+		assert.Nil(t, contract.roundRequested)
+	})
+
+	t.Run("bounds the fetched transactions", func(t *testing.T) {
+		var sigs []*rpc.TransactionSignature
+		logs := map[solana.Signature][]string{}
+		for i := 0; i < roundRequestedSignaturesLimit; i++ {
+			sig := solana.Signature{byte(i + 1)}
+			sigs = append(sigs, &rpc.TransactionSignature{Signature: sig, BlockTime: &now})
+			if i < roundRequestedTransactionsLimit {
+				logs[sig] = testEventLogs(programID)
+			}
+		}
+		contract := setup(t, sigs, logs)
+
+		configDigest, epoch, round, err := contract.LatestRoundRequested(tests.Context(t), time.Minute)
+		require.NoError(t, err)
+		assert.Zero(t, configDigest)
+		assert.Zero(t, epoch)
+		assert.Zero(t, round)
+	})
+
+	t.Run("only searches transactions since the previous search", func(t *testing.T) {
+		sigs := []*rpc.TransactionSignature{
+			{Signature: solana.Signature{2}, BlockTime: &now},
+		}
+		contract := setup(t, sigs, map[solana.Signature][]string{
+			{2}: testEventLogs(programID, testRoundRequestedEvent(digest, 3, 4)),
+		})
+
+		_, _, round, err := contract.LatestRoundRequested(tests.Context(t), time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(4), round)
+
+		// no new transactions, the previous result is used
+		reader := contract.reader.(*clientmocks.ReaderWriter)
+		untilPrevious := mock.MatchedBy(func(opts *rpc.GetSignaturesForAddressOpts) bool {
+			return opts.Until == solana.Signature{2}
+		})
+		reader.On("GetSignaturesForAddressWithOpts", mock.Anything, stateID, untilPrevious).Return([]*rpc.TransactionSignature{}, nil).Once()
+
+		configDigest, epoch, round, err := contract.LatestRoundRequested(tests.Context(t), time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, digest[:], configDigest[:])
+		assert.Equal(t, uint32(3), epoch)
+		assert.Equal(t, uint8(4), round)
+
+		// a new transaction without events continues the previous search
+		reader.On("GetSignaturesForAddressWithOpts", mock.Anything, stateID, untilPrevious).Return([]*rpc.TransactionSignature{
+			{Signature: solana.Signature{3}, BlockTime: &now},
+		}, nil).Once()
+		reader.On("GetTransaction", mock.Anything, solana.Signature{3}, mock.Anything).Return(&rpc.GetTransactionResult{
+			Meta: &rpc.TransactionMeta{LogMessages: testEventLogs(programID)},
+		}, nil).Once()
+
+		_, _, round, err = contract.LatestRoundRequested(tests.Context(t), time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, uint8(4), round)
+
+		// a new transmission supersedes the requested round
+		reader.On("GetSignaturesForAddressWithOpts", mock.Anything, stateID, mock.MatchedBy(func(opts *rpc.GetSignaturesForAddressOpts) bool {
+			return opts.Until == solana.Signature{3}
+		})).Return([]*rpc.TransactionSignature{
+			{Signature: solana.Signature{4}, BlockTime: &now},
+		}, nil).Once()
+		reader.On("GetTransaction", mock.Anything, solana.Signature{4}, mock.Anything).Return(&rpc.GetTransactionResult{
+			Meta: &rpc.TransactionMeta{LogMessages: testEventLogs(programID, testNewTransmissionEvent)},
+		}, nil).Once()
+
+		_, _, round, err = contract.LatestRoundRequested(tests.Context(t), time.Minute)
+		require.NoError(t, err)
+		assert.Zero(t, round)
+	})
+}
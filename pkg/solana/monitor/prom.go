@@ -1,6 +1,7 @@
 package monitor
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/gagliardetto/solana-go"
@@ -19,6 +20,38 @@ var (
 		prometheus.GaugeOpts{Name: "solana_cache_last_update_unix", Help: "Solana relayer cache last update timestamp"},
 		[]string{"type", "chainID", "account"},
 	)
+	promSkippedTransmissions = promauto.NewCounterVec(
+		prometheus.CounterOpts{Name: "solana_ocr2_transmissions_skipped", Help: "Number of reports not transmitted because the same or a later round already landed on chain"},
+		[]string{"chainID", "account"},
+	)
+	promBatchedTransmissions = promauto.NewCounterVec(
+		prometheus.CounterOpts{Name: "solana_ocr2_transmissions_batched", Help: "Number of reports sent by the transmission batcher by status (batched, single, split, resent)"},
+		[]string{"chainID", "account", "status"},
+	)
+	promConfigDigestMismatch = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{Name: "solana_ocr2_config_digest_mismatch", Help: "Set to 1 if the on-chain config digest does not match the digest recomputed from the stored config"},
+		[]string{"chainID", "account"},
+	)
+	promTransmitterPoolBalance = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{Name: "solana_ocr2_transmitter_pool_balance", Help: "Solana balance of the keys of a transmitter pool"},
+		[]string{"chainID", "account", "transmitter"},
+	)
+	promLinkAvailableForPayment = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{Name: "solana_ocr2_link_available_for_payment", Help: "LINK in the token vault of a feed not owed to oracles, negative if underfunded"},
+		[]string{"chainID", "account"},
+	)
+	promLinkRunwayRounds = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{Name: "solana_ocr2_link_runway_rounds", Help: "Estimated number of rounds the LINK available for payment pays for"},
+		[]string{"chainID", "account"},
+	)
+	promLinkRunwayDays = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{Name: "solana_ocr2_link_runway_days", Help: "Estimated days until the LINK available for payment is spent at the observed round rate"},
+		[]string{"chainID", "account"},
+	)
+	promOraclePaymentOwed = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{Name: "solana_ocr2_oracle_payment_owed", Help: "LINK owed to an oracle of a feed, by the transmitter of the oracle"},
+		[]string{"chainID", "account", "transmitter"},
+	)
 	promClientReq = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{Name: "solana_client_latency_ms", Help: "Solana client request latency"},
 		[]string{"request", "url"},
@@ -38,6 +71,72 @@ func SetCacheTimestamp(t time.Time, cacheType, chainID, account string) {
 	}).Set(float64(t.Unix()))
 }
 
+func IncSkippedTransmissions(chainID, account string) {
+	promSkippedTransmissions.With(prometheus.Labels{
+		"chainID": chainID,
+		"account": account,
+	}).Inc()
+}
+
+func IncBatchedTransmissions(chainID, account, status string) {
+	promBatchedTransmissions.With(prometheus.Labels{
+		"chainID": chainID,
+		"account": account,
+		"status":  status,
+	}).Inc()
+}
+
+func SetConfigDigestMismatch(chainID, account string, mismatch bool) {
+	var v float64
+	if mismatch {
+		v = 1
+	}
+	promConfigDigestMismatch.With(prometheus.Labels{
+		"chainID": chainID,
+		"account": account,
+	}).Set(v)
+}
+
+func SetTransmitterPoolBalance(chainID, account, transmitter string, lamports uint64) {
+	v := internal.LamportsToSol(lamports) // convert from lamports to SOL
+	promTransmitterPoolBalance.With(prometheus.Labels{
+		"chainID":     chainID,
+		"account":     account,
+		"transmitter": transmitter,
+	}).Set(v)
+}
+
+func SetLinkAvailableForPayment(chainID, account string, gjuels *big.Int) {
+	// convert from gjuels to LINK
+	v, _ := new(big.Float).Quo(new(big.Float).SetInt(gjuels), big.NewFloat(1e9)).Float64()
+	promLinkAvailableForPayment.With(prometheus.Labels{
+		"chainID": chainID,
+		"account": account,
+	}).Set(v)
+}
+
+func SetLinkRunwayRounds(chainID, account string, rounds uint64) {
+	promLinkRunwayRounds.With(prometheus.Labels{
+		"chainID": chainID,
+		"account": account,
+	}).Set(float64(rounds))
+}
+
+func SetLinkRunwayDays(chainID, account string, runway time.Duration) {
+	promLinkRunwayDays.With(prometheus.Labels{
+		"chainID": chainID,
+		"account": account,
+	}).Set(runway.Hours() / 24)
+}
+
+func SetOraclePaymentOwed(chainID, account, transmitter string, gjuels uint64) {
+	promOraclePaymentOwed.With(prometheus.Labels{
+		"chainID":     chainID,
+		"account":     account,
+		"transmitter": transmitter,
+	}).Set(float64(gjuels) / 1e9) // convert from gjuels to LINK
+}
+
 func SetClientLatency(d time.Duration, request, url string) {
 	promClientReq.With(prometheus.Labels{
 		"request": request,
@@ -0,0 +1,172 @@
+package solana
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+)
+
+var _ ocr3types.ContractTransmitter[[]byte] = (*OCR3Transmitter)(nil)
+
+// OCR3TransmitterConfig is the `ocr3Transmitter` member of the RelayConfig. It configures the program receiving
+// OCR3 reports and how reports and signatures are packed into the instruction data:
+//
+//	discriminator || [config_digest || seq_nr (u64 LE)] || report_len (u32 LE) || report || sig_count (u8) || [signer (u8)] sig...
+type OCR3TransmitterConfig struct {
+	ProgramID     string        `json:"programID"`     // required, program receiving the reports
+	Accounts      []OCR3Account `json:"accounts"`      // passed after the state and transmitter accounts
+	Discriminator string        `json:"discriminator"` // hex encoded instruction prefix, e.g. the anchor instruction discriminator
+
+	// packing of the report and signatures
+	ReportContext bool `json:"reportContext"` // prefix the report with the report context, see PluginTransmitter for OCR2 plugins
+	SignerIndexes bool `json:"signerIndexes"` // prefix each signature with the oracle ID of its signer
+}
+
+// OCR3Account is an additional account passed to the transmit instruction
+type OCR3Account struct {
+	PublicKey  string `json:"publicKey"`
+	IsWritable bool   `json:"isWritable"`
+}
+
+// OCR3Transmitter sends OCR3 reports and signatures to the configured program through the txm
+type OCR3Transmitter struct {
+	stateID, programID, transmissionSigner solana.PublicKey
+	accounts                               []*solana.AccountMeta
+	discriminator                          []byte
+	reportContext, signerIndexes           bool
+	reader                                 client.Reader
+	lggr                                   logger.Logger
+	txManager                              TxManager
+}
+
+func NewOCR3Transmitter(cfg OCR3TransmitterConfig, stateID, transmissionSigner solana.PublicKey, reader client.Reader, txManager TxManager, lggr logger.Logger) (*OCR3Transmitter, error) {
+	programID, err := solana.PublicKeyFromBase58(cfg.ProgramID)
+	if err != nil {
+		return nil, fmt.Errorf("error on 'solana.PublicKeyFromBase58' for 'spec.RelayConfig.OCR3Transmitter.ProgramID: %w", err)
+	}
+
+	accounts := make([]*solana.AccountMeta, len(cfg.Accounts))
+	for i, a := range cfg.Accounts {
+		pubKey, err := solana.PublicKeyFromBase58(a.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("error on 'solana.PublicKeyFromBase58' for 'spec.RelayConfig.OCR3Transmitter.Accounts[%d]: %w", i, err)
+		}
+		accounts[i] = &solana.AccountMeta{PublicKey: pubKey, IsWritable: a.IsWritable}
+	}
+
+	discriminator, err := hex.DecodeString(cfg.Discriminator)
+	if err != nil {
+		return nil, fmt.Errorf("error on decoding 'spec.RelayConfig.OCR3Transmitter.Discriminator: %w", err)
+	}
+
+	return &OCR3Transmitter{
+		stateID:            stateID,
+		programID:          programID,
+		transmissionSigner: transmissionSigner,
+		accounts:           accounts,
+		discriminator:      discriminator,
+		reportContext:      cfg.ReportContext,
+		signerIndexes:      cfg.SignerIndexes,
+		reader:             reader,
+		lggr:               logger.Named(lggr, "OCR3Transmitter"),
+		txManager:          txManager,
+	}, nil
+}
+
+// Transmit sends the report to the configured program
+func (c *OCR3Transmitter) Transmit(
+	ctx context.Context,
+	configDigest types.ConfigDigest,
+	seqNr uint64,
+	report ocr3types.ReportWithInfo[[]byte],
+	sigs []types.AttributedOnchainSignature,
+) error {
+	return c.transmit(ctx, binary.LittleEndian.AppendUint64(bytes.Clone(configDigest[:]), seqNr), report.Report, sigs)
+}
+
+// transmit sends the report with the encoded report context, which is only packed if configured
+func (c *OCR3Transmitter) transmit(
+	ctx context.Context,
+	reportContext []byte,
+	report types.Report,
+	sigs []types.AttributedOnchainSignature,
+) error {
+	instruction, err := c.instruction(reportContext, report, sigs)
+	if err != nil {
+		return err
+	}
+
+	blockhash, err := c.reader.LatestBlockhash(ctx)
+	if err != nil {
+		return fmt.Errorf("error on Transmit.GetRecentBlockhash: %w", err)
+	}
+	if blockhash == nil || blockhash.Value == nil {
+		return errors.New("nil pointer returned from Transmit.GetRecentBlockhash")
+	}
+
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{instruction},
+		blockhash.Value.Blockhash,
+		solana.TransactionPayer(c.transmissionSigner),
+	)
+	if err != nil {
+		return fmt.Errorf("error on Transmit.NewTransaction: %w", err)
+	}
+
+	c.lggr.Debugw("Queuing transmit tx", "state", c.stateID, "program", c.programID)
+	if err = c.txManager.Enqueue(ctx, c.stateID.String(), tx); err != nil {
+		return fmt.Errorf("error on Transmit.txManager.Enqueue: %w", err)
+	}
+	return nil
+}
+
+// instruction builds the transmit instruction of the report
+func (c *OCR3Transmitter) instruction(
+	reportContext []byte,
+	report types.Report,
+	sigs []types.AttributedOnchainSignature,
+) (solana.Instruction, error) {
+	if len(sigs) > 255 {
+		return nil, fmt.Errorf("too many signatures: %d", len(sigs))
+	}
+
+	accounts := []*solana.AccountMeta{
+		// state, transmitter, configured accounts
+		{PublicKey: c.stateID, IsWritable: true, IsSigner: false},
+		{PublicKey: c.transmissionSigner, IsWritable: false, IsSigner: true},
+	}
+	accounts = append(accounts, c.accounts...)
+
+	data := new(bytes.Buffer)
+	data.Write(c.discriminator)
+	if c.reportContext {
+		data.Write(reportContext)
+	}
+	_ = binary.Write(data, binary.LittleEndian, uint32(len(report))) //nolint:gosec // reports are limited by the tx size
+	data.Write(report)
+	data.WriteByte(uint8(len(sigs)))
+	for _, sig := range sigs {
+		if c.signerIndexes {
+			data.WriteByte(uint8(sig.Signer))
+		}
+		// Signature = 64 bytes + 1 byte recovery id
+		data.Write(sig.Signature)
+	}
+
+	return solana.NewInstruction(c.programID, accounts, data.Bytes()), nil
+}
+
+func (c *OCR3Transmitter) FromAccount(ctx context.Context) (types.Account, error) {
+	return types.Account(c.transmissionSigner.String()), nil
+}
@@ -0,0 +1,107 @@
+package solana
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	clientmocks "github.com/smartcontractkit/chainlink-solana/pkg/solana/client/mocks"
+)
+
+func TestOCR3Transmitter(t *testing.T) {
+	programID := solana.NewWallet().PublicKey()
+	stateID := solana.NewWallet().PublicKey()
+	signer := solana.NewWallet().PublicKey()
+	account := solana.NewWallet().PublicKey()
+
+	report := ocr3types.ReportWithInfo[[]byte]{Report: types.Report{1, 2, 3}}
+	sigs := []types.AttributedOnchainSignature{
+		{Signature: bytes.Repeat([]byte{4}, 65), Signer: 1},
+		{Signature: bytes.Repeat([]byte{5}, 65), Signer: 3},
+	}
+	digest := types.ConfigDigest{6}
+
+	setup := func(t *testing.T, cfg OCR3TransmitterConfig) (*OCR3Transmitter, *enqueuedTxs) {
+		reader := clientmocks.NewReaderWriter(t)
+		reader.On("LatestBlockhash", mock.Anything).Return(&rpc.GetLatestBlockhashResult{
+			Value: &rpc.LatestBlockhashResult{},
+		}, nil).Once()
+
+		txManager := &enqueuedTxs{}
+		transmitter, err := NewOCR3Transmitter(cfg, stateID, signer, reader, txManager, logger.Test(t))
+		require.NoError(t, err)
+		return transmitter, txManager
+	}
+
+	t.Run("packs report and signatures", func(t *testing.T) {
+		transmitter, txManager := setup(t, OCR3TransmitterConfig{ProgramID: programID.String()})
+		require.NoError(t, transmitter.Transmit(tests.Context(t), digest, 7, report, sigs))
+		require.Len(t, txManager.txs, 1)
+
+		expected := []byte{3, 0, 0, 0, 1, 2, 3, 2}
+		expected = append(expected, sigs[0].Signature...)
+		expected = append(expected, sigs[1].Signature...)
+		assert.Equal(t, expected, []byte(txManager.txs[0].Message.Instructions[0].Data))
+
+		from, err := transmitter.FromAccount(tests.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, types.Account(signer.String()), from)
+	})
+
+	t.Run("packs discriminator, report context and signer indexes", func(t *testing.T) {
+		transmitter, txManager := setup(t, OCR3TransmitterConfig{
+			ProgramID:     programID.String(),
+			Accounts:      []OCR3Account{{PublicKey: account.String(), IsWritable: true}},
+			Discriminator: "0a0b",
+			ReportContext: true,
+			SignerIndexes: true,
+		})
+		require.NoError(t, transmitter.Transmit(tests.Context(t), digest, 7, report, sigs))
+		require.Len(t, txManager.txs, 1)
+		tx := txManager.txs[0]
+
+		var expected bytes.Buffer
+		expected.Write([]byte{0x0a, 0x0b})
+		expected.Write(digest[:])
+		require.NoError(t, binary.Write(&expected, binary.LittleEndian, uint64(7)))
+		expected.Write([]byte{3, 0, 0, 0, 1, 2, 3, 2})
+		expected.WriteByte(1)
+		expected.Write(sigs[0].Signature)
+		expected.WriteByte(3)
+		expected.Write(sigs[1].Signature)
+		assert.Equal(t, expected.Bytes(), []byte(tx.Message.Instructions[0].Data))
+
+		accounts, err := tx.Message.Instructions[0].ResolveInstructionAccounts(&tx.Message)
+		require.NoError(t, err)
+		require.Len(t, accounts, 3)
+		assert.Equal(t, stateID, accounts[0].PublicKey)
+		assert.Equal(t, signer, accounts[1].PublicKey)
+		assert.True(t, accounts[1].IsSigner)
+		assert.Equal(t, account, accounts[2].PublicKey)
+		assert.True(t, accounts[2].IsWritable)
+
+		program, err := tx.Message.Program(tx.Message.Instructions[0].ProgramIDIndex)
+		require.NoError(t, err)
+		assert.Equal(t, programID, program)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := NewOCR3Transmitter(OCR3TransmitterConfig{}, stateID, signer, nil, nil, logger.Test(t))
+		require.Error(t, err)
+		_, err = NewOCR3Transmitter(OCR3TransmitterConfig{ProgramID: programID.String(), Discriminator: "zz"}, stateID, signer, nil, nil, logger.Test(t))
+		require.Error(t, err)
+		_, err = NewOCR3Transmitter(OCR3TransmitterConfig{ProgramID: programID.String(), Accounts: []OCR3Account{{PublicKey: "invalid"}}}, stateID, signer, nil, nil, logger.Test(t))
+		require.Error(t, err)
+	})
+}
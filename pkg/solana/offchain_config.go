@@ -0,0 +1,83 @@
+package solana
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/monitoring/pb"
+)
+
+// DecodedOffchainConfig is the public part of the OCR2 offchain config, with the config of the median
+// reporting plugin decoded. The shared secret is encrypted for each oracle and is not decoded.
+type DecodedOffchainConfig struct {
+	DeltaProgress      time.Duration
+	DeltaResend        time.Duration
+	DeltaRound         time.Duration
+	DeltaGrace         time.Duration
+	DeltaStage         time.Duration
+	RMax               uint32
+	S                  []uint32
+	OffchainPublicKeys [][]byte
+	PeerIDs            []string
+
+	MaxDurationQuery                        time.Duration
+	MaxDurationObservation                  time.Duration
+	MaxDurationReport                       time.Duration
+	MaxDurationShouldAcceptFinalizedReport  time.Duration
+	MaxDurationShouldTransmitAcceptedReport time.Duration
+
+	Median median.OffchainConfig
+}
+
+// DecodeOffchainConfig decodes the offchain config stored in a state account. It is decoded as is, without
+// the checks libocr runs before accepting a config.
+func DecodeOffchainConfig(offchainConfig OffchainConfig) (DecodedOffchainConfig, error) {
+	data, err := offchainConfig.Data()
+	if err != nil {
+		return DecodedOffchainConfig{}, err
+	}
+	return DecodeOffchainConfigData(offchainConfig.Version, data)
+}
+
+// DecodeOffchainConfigData decodes an encoded OCR2 offchain config, such as types.ContractConfig.OffchainConfig
+func DecodeOffchainConfigData(version uint64, data []byte) (DecodedOffchainConfig, error) {
+	if version != 2 {
+		return DecodedOffchainConfig{}, fmt.Errorf("unsupported offchain config version: %d", version)
+	}
+	if len(data) == 0 {
+		return DecodedOffchainConfig{}, errors.New("offchain config is empty")
+	}
+
+	var config pb.OffchainConfigProto
+	if err := proto.Unmarshal(data, &config); err != nil {
+		return DecodedOffchainConfig{}, fmt.Errorf("error on decoding offchain config: %w", err)
+	}
+	medianConfig, err := median.DecodeOffchainConfig(config.ReportingPluginConfig)
+	if err != nil {
+		return DecodedOffchainConfig{}, fmt.Errorf("error on decoding median reporting plugin config: %w", err)
+	}
+
+	return DecodedOffchainConfig{
+		DeltaProgress:      time.Duration(config.DeltaProgressNanoseconds),
+		DeltaResend:        time.Duration(config.DeltaResendNanoseconds),
+		DeltaRound:         time.Duration(config.DeltaRoundNanoseconds),
+		DeltaGrace:         time.Duration(config.DeltaGraceNanoseconds),
+		DeltaStage:         time.Duration(config.DeltaStageNanoseconds),
+		RMax:               config.RMax,
+		S:                  config.S,
+		OffchainPublicKeys: config.OffchainPublicKeys,
+		PeerIDs:            config.PeerIds,
+
+		MaxDurationQuery:                        time.Duration(config.MaxDurationQueryNanoseconds),
+		MaxDurationObservation:                  time.Duration(config.MaxDurationObservationNanoseconds),
+		MaxDurationReport:                       time.Duration(config.MaxDurationReportNanoseconds),
+		MaxDurationShouldAcceptFinalizedReport:  time.Duration(config.MaxDurationShouldAcceptFinalizedReportNanoseconds),
+		MaxDurationShouldTransmitAcceptedReport: time.Duration(config.MaxDurationShouldTransmitAcceptedReportNanoseconds),
+
+		Median: medianConfig,
+	}, nil
+}
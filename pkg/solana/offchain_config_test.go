@@ -0,0 +1,85 @@
+package solana
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/monitoring/pb"
+)
+
+func TestDecodeOffchainConfig(t *testing.T) {
+	medianConfig := median.OffchainConfig{
+		AlphaReportPPB: 5_000_000,
+		AlphaAcceptPPB: 1_000_000,
+		DeltaC:         time.Hour,
+	}
+	encode := func(t *testing.T, reportingPluginConfig []byte) OffchainConfig {
+		data, err := proto.Marshal(&pb.OffchainConfigProto{
+			DeltaProgressNanoseconds:    uint64(10 * time.Second),
+			DeltaResendNanoseconds:      uint64(5 * time.Second),
+			DeltaRoundNanoseconds:       uint64(2 * time.Second),
+			DeltaGraceNanoseconds:       uint64(time.Second),
+			DeltaStageNanoseconds:       uint64(20 * time.Second),
+			RMax:                        3,
+			S:                           []uint32{1, 1},
+			OffchainPublicKeys:          [][]byte{{1}, {2}},
+			PeerIds:                     []string{"peer1", "peer2"},
+			ReportingPluginConfig:       reportingPluginConfig,
+			MaxDurationQueryNanoseconds: uint64(50 * time.Millisecond),
+			MaxDurationShouldTransmitAcceptedReportNanoseconds: uint64(time.Second),
+		})
+		require.NoError(t, err)
+
+		offchainConfig := OffchainConfig{Version: 2}
+		offchainConfig.Len = uint64(copy(offchainConfig.Raw[:], data))
+		return offchainConfig
+	}
+
+	decoded, err := DecodeOffchainConfig(encode(t, medianConfig.Encode()))
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Second, decoded.DeltaProgress)
+	assert.Equal(t, 5*time.Second, decoded.DeltaResend)
+	assert.Equal(t, 2*time.Second, decoded.DeltaRound)
+	assert.Equal(t, time.Second, decoded.DeltaGrace)
+	assert.Equal(t, 20*time.Second, decoded.DeltaStage)
+	assert.Equal(t, uint32(3), decoded.RMax)
+	assert.Equal(t, []uint32{1, 1}, decoded.S)
+	assert.Equal(t, [][]byte{{1}, {2}}, decoded.OffchainPublicKeys)
+	assert.Equal(t, []string{"peer1", "peer2"}, decoded.PeerIDs)
+	assert.Equal(t, 50*time.Millisecond, decoded.MaxDurationQuery)
+	assert.Equal(t, time.Duration(0), decoded.MaxDurationObservation)
+	assert.Equal(t, time.Second, decoded.MaxDurationShouldTransmitAcceptedReport)
+	assert.Equal(t, medianConfig, decoded.Median)
+
+	t.Run("unconfigured", func(t *testing.T) {
+		_, err := DecodeOffchainConfig(OffchainConfig{Version: 2})
+		require.ErrorContains(t, err, "offchain config is empty")
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		offchainConfig := encode(t, medianConfig.Encode())
+		offchainConfig.Version = 1
+		_, err := DecodeOffchainConfig(offchainConfig)
+		require.ErrorContains(t, err, "unsupported offchain config version")
+	})
+
+	t.Run("invalid length", func(t *testing.T) {
+		_, err := DecodeOffchainConfig(OffchainConfig{Version: 2, Len: MaxOffchainConfigLen + 1})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid offchain config", func(t *testing.T) {
+		_, err := DecodeOffchainConfigData(2, []byte{0xff})
+		require.ErrorContains(t, err, "error on decoding offchain config")
+	})
+
+	t.Run("invalid reporting plugin config", func(t *testing.T) {
+		_, err := DecodeOffchainConfig(encode(t, []byte{0xff}))
+		require.ErrorContains(t, err, "error on decoding median reporting plugin config")
+	})
+}
@@ -0,0 +1,259 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sync"
+	"time"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/monitor"
+)
+
+// ErrInsufficientLinkForPayment is reported when the token vault does not cover the payments owed to the oracles
+var ErrInsufficientLinkForPayment = errors.New("insufficient LINK in token vault for oracle payments")
+
+// OwedPayment returns the payment in gjuels owed to the oracle, matching calculate_owed_payment_gjuels of the ocr2 program
+func OwedPayment(config Config, oracle Oracle) uint64 {
+	var rounds uint32 // prevent overflow if RoundID is larger than latest aggregator RoundID
+	if config.LatestAggregatorRoundID >= oracle.FromRoundID {
+		rounds = config.LatestAggregatorRoundID - oracle.FromRoundID
+	}
+	return uint64(config.Billing.ObservationPayment)*uint64(rounds) + oracle.Payment
+}
+
+// OraclePayment is the payment owed to an oracle
+type OraclePayment struct {
+	Transmitter solana.PublicKey
+	Payee       solana.PublicKey
+	Owed        uint64 // gjuels
+}
+
+// Payments summarizes the liabilities of a feed against the balance of its token vault. All amounts are in gjuels.
+type Payments struct {
+	Oracles       []OraclePayment
+	TotalOwed     uint64
+	LinkBalance   *big.Int
+	LinkAvailable *big.Int // negative if the vault does not cover the payments owed
+	RoundCost     uint64   // maximum payment accrued by a round: every oracle observing and a transmission reimbursed
+}
+
+// CalculatePayments computes the payments owed to the oracles of the state and the LINK left for future rounds
+func CalculatePayments(state State, linkBalance *big.Int) (Payments, error) {
+	oracles, err := state.Oracles.Data()
+	if err != nil {
+		return Payments{}, err
+	}
+
+	payments := Payments{
+		Oracles:     make([]OraclePayment, len(oracles)),
+		LinkBalance: linkBalance,
+		RoundCost:   uint64(state.Config.Billing.ObservationPayment)*uint64(len(oracles)) + uint64(state.Config.Billing.TransmissionPayment),
+	}
+	for i, oracle := range oracles {
+		owed := OwedPayment(state.Config, oracle)
+		payments.Oracles[i] = OraclePayment{Transmitter: oracle.Transmitter, Payee: oracle.Payee, Owed: owed}
+		payments.TotalOwed += owed
+	}
+	payments.LinkAvailable = new(big.Int).Sub(linkBalance, new(big.Int).SetUint64(payments.TotalOwed))
+	return payments, nil
+}
+
+// RunwayRounds returns the estimated number of rounds the available LINK pays for.
+// math.MaxUint64 is returned if rounds are free.
+func (p Payments) RunwayRounds() uint64 {
+	if p.LinkAvailable.Sign() <= 0 {
+		return 0
+	}
+	if p.RoundCost == 0 {
+		return math.MaxUint64
+	}
+	rounds := new(big.Int).Div(p.LinkAvailable, new(big.Int).SetUint64(p.RoundCost))
+	if !rounds.IsUint64() {
+		return math.MaxUint64
+	}
+	return rounds.Uint64()
+}
+
+// Runway returns the estimated time until the available LINK is spent, given the average interval between rounds
+func (p Payments) Runway(roundInterval time.Duration) time.Duration {
+	if roundInterval <= 0 {
+		return 0
+	}
+	rounds := p.RunwayRounds()
+	if rounds > uint64(math.MaxInt64/roundInterval) {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(rounds) * roundInterval
+}
+
+// GetTokenBalance returns the balance of a token account
+func GetTokenBalance(ctx context.Context, reader client.AccountReader, account solana.PublicKey, commitment rpc.CommitmentType) (uint64, error) {
+	res, err := reader.GetAccountInfoWithOpts(ctx, account, &rpc.GetAccountInfoOpts{
+		Commitment: commitment,
+		Encoding:   "base64",
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch token account at address '%s': %w", account.String(), err)
+	}
+
+	// check for nil pointers
+	if res == nil || res.Value == nil || res.Value.Data == nil {
+		return 0, errors.New("nil pointer returned in GetTokenBalance.GetAccountInfoWithOpts")
+	}
+
+	var tokenAccount token.Account
+	if err = bin.NewBinDecoder(res.Value.Data.GetBinary()).Decode(&tokenAccount); err != nil {
+		return 0, fmt.Errorf("failed to decode token account at address '%s': %w", account.String(), err)
+	}
+	return tokenAccount.Amount, nil
+}
+
+// PaymentsTracker polls the token vault of a feed, reports the LINK available for payment, the runway and the payments owed to each oracle,
+// and reports unhealthy if the vault does not cover the payments owed to the oracles.
+type PaymentsTracker struct {
+	services.StateMachine
+	chainID    string
+	stateID    solana.PublicKey
+	stateCache *StateCache
+	reader     client.AccountReader
+	commitment rpc.CommitmentType
+	pollPeriod time.Duration
+	lggr       logger.Logger
+
+	lock     sync.RWMutex
+	payments *Payments
+
+	// first observed aggregator round, used to estimate the interval between rounds
+	firstRoundID   uint32
+	firstRoundTime time.Time
+
+	stopCh services.StopChan
+	done   chan struct{}
+}
+
+func NewPaymentsTracker(chainID string, stateID solana.PublicKey, stateCache *StateCache, reader client.AccountReader, commitment rpc.CommitmentType, pollPeriod time.Duration, lggr logger.Logger) *PaymentsTracker {
+	return &PaymentsTracker{
+		chainID:    chainID,
+		stateID:    stateID,
+		stateCache: stateCache,
+		reader:     reader,
+		commitment: commitment,
+		pollPeriod: pollPeriod,
+		lggr:       logger.Named(lggr, "PaymentsTracker"),
+		stopCh:     make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+func (p *PaymentsTracker) Name() string {
+	return p.lggr.Name()
+}
+
+func (p *PaymentsTracker) Start(context.Context) error {
+	return p.StartOnce("PaymentsTracker", func() error {
+		go p.poll()
+		return nil
+	})
+}
+
+func (p *PaymentsTracker) Close() error {
+	return p.StopOnce("PaymentsTracker", func() error {
+		close(p.stopCh)
+		<-p.done
+		return nil
+	})
+}
+
+func (p *PaymentsTracker) HealthReport() map[string]error {
+	err := p.Healthy()
+	if err == nil {
+		if payments, ok := p.Payments(); ok && payments.LinkAvailable.Sign() < 0 {
+			err = fmt.Errorf("%w: %s gjuels missing", ErrInsufficientLinkForPayment, new(big.Int).Neg(payments.LinkAvailable))
+		}
+	}
+	return map[string]error{p.Name(): err}
+}
+
+// Payments returns the latest payments, false if the token vault was not read yet
+func (p *PaymentsTracker) Payments() (Payments, bool) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	if p.payments == nil {
+		return Payments{}, false
+	}
+	return *p.payments, true
+}
+
+func (p *PaymentsTracker) poll() {
+	defer close(p.done)
+	ctx, cancel := p.stopCh.NewCtx()
+	defer cancel()
+
+	tick := time.After(0)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick:
+			if err := p.update(ctx); err != nil && ctx.Err() == nil {
+				p.lggr.Warnw("Failed to update payments", "err", err)
+			}
+			tick = time.After(utils.WithJitter(p.pollPeriod))
+		}
+	}
+}
+
+func (p *PaymentsTracker) update(ctx context.Context) error {
+	state, err := p.stateCache.Read()
+	if err != nil {
+		return fmt.Errorf("error on PaymentsTracker.stateCache.Read: %w", err)
+	}
+	balance, err := GetTokenBalance(ctx, p.reader, state.Config.TokenVault, p.commitment)
+	if err != nil {
+		return err
+	}
+	payments, err := CalculatePayments(state, new(big.Int).SetUint64(balance))
+	if err != nil {
+		return err
+	}
+
+	p.lock.Lock()
+	p.payments = &payments
+	p.lock.Unlock()
+
+	monitor.SetLinkAvailableForPayment(p.chainID, p.stateID.String(), payments.LinkAvailable)
+	monitor.SetLinkRunwayRounds(p.chainID, p.stateID.String(), payments.RunwayRounds())
+	if interval := p.roundInterval(state.Config.LatestAggregatorRoundID, time.Now()); interval > 0 {
+		monitor.SetLinkRunwayDays(p.chainID, p.stateID.String(), payments.Runway(interval))
+	}
+	for _, oracle := range payments.Oracles {
+		monitor.SetOraclePaymentOwed(p.chainID, p.stateID.String(), oracle.Transmitter.String(), oracle.Owed)
+	}
+	return nil
+}
+
+// roundInterval returns the average interval between the rounds that landed since the first observed round,
+// 0 until a round landed.
+func (p *PaymentsTracker) roundInterval(roundID uint32, now time.Time) time.Duration {
+	if p.firstRoundTime.IsZero() || roundID < p.firstRoundID {
+		p.firstRoundID, p.firstRoundTime = roundID, now
+		return 0
+	}
+	if roundID == p.firstRoundID {
+		return 0
+	}
+	return now.Sub(p.firstRoundTime) / time.Duration(roundID-p.firstRoundID)
+}
@@ -0,0 +1,120 @@
+package solana
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	clientmocks "github.com/smartcontractkit/chainlink-solana/pkg/solana/client/mocks"
+)
+
+func testPaymentsState() State {
+	var state State
+	state.Config.TokenVault = solana.NewWallet().PublicKey()
+	state.Config.LatestAggregatorRoundID = 10
+	state.Config.Billing = Billing{ObservationPayment: 2, TransmissionPayment: 5}
+	state.Oracles.Len = 3
+	state.Oracles.Raw[0] = Oracle{Transmitter: solana.NewWallet().PublicKey(), FromRoundID: 4, Payment: 7}
+	state.Oracles.Raw[1] = Oracle{Transmitter: solana.NewWallet().PublicKey(), FromRoundID: 10}
+	state.Oracles.Raw[2] = Oracle{Transmitter: solana.NewWallet().PublicKey(), FromRoundID: 11, Payment: 1} // joined after the latest round
+	return state
+}
+
+func TestCalculatePayments(t *testing.T) {
+	state := testPaymentsState()
+
+	payments, err := CalculatePayments(state, big.NewInt(100))
+	require.NoError(t, err)
+	require.Len(t, payments.Oracles, 3)
+	assert.Equal(t, uint64(2*6+7), payments.Oracles[0].Owed)
+	assert.Equal(t, state.Oracles.Raw[0].Transmitter, payments.Oracles[0].Transmitter)
+	assert.Equal(t, uint64(0), payments.Oracles[1].Owed)
+	assert.Equal(t, uint64(1), payments.Oracles[2].Owed)
+	assert.Equal(t, uint64(20), payments.TotalOwed)
+	assert.Equal(t, big.NewInt(80), payments.LinkAvailable)
+	assert.Equal(t, uint64(2*3+5), payments.RoundCost)
+	assert.Equal(t, uint64(7), payments.RunwayRounds())
+	assert.Equal(t, 7*time.Minute, payments.Runway(time.Minute))
+	assert.Equal(t, time.Duration(0), payments.Runway(0))
+
+	// underfunded
+	payments, err = CalculatePayments(state, big.NewInt(10))
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(-10), payments.LinkAvailable)
+	assert.Equal(t, uint64(0), payments.RunwayRounds())
+
+	// free rounds
+	state.Config.Billing = Billing{}
+	payments, err = CalculatePayments(state, big.NewInt(10))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(math.MaxUint64), payments.RunwayRounds())
+	assert.Equal(t, time.Duration(math.MaxInt64), payments.Runway(time.Second))
+
+	// invalid oracles
+	state.Oracles.Len = MaxOracles + 1
+	_, err = CalculatePayments(state, big.NewInt(10))
+	require.Error(t, err)
+}
+
+func TestPaymentsTracker(t *testing.T) {
+	ctx := tests.Context(t)
+	state := testPaymentsState()
+	stateCache := testStateCache(t, func(context.Context) (State, uint64, error) { return state, 0, nil })
+
+	tokenAccount := func(amount uint64) *rpc.GetAccountInfoResult {
+		data, err := bin.MarshalBin(token.Account{Amount: amount})
+		require.NoError(t, err)
+		return &rpc.GetAccountInfoResult{Value: &rpc.Account{Data: rpc.DataBytesOrJSONFromBytes(data)}}
+	}
+	reader := clientmocks.NewReaderWriter(t)
+	reader.On("GetAccountInfoWithOpts", mock.Anything, state.Config.TokenVault, mock.Anything).Return(tokenAccount(100), nil).Once()
+	reader.On("GetAccountInfoWithOpts", mock.Anything, state.Config.TokenVault, mock.Anything).Return(tokenAccount(10), nil).Once()
+
+	tracker := NewPaymentsTracker("test-chain-id", solana.PublicKey{}, stateCache, reader, rpc.CommitmentConfirmed, time.Minute, logger.Test(t))
+
+	// state not read yet
+	require.Error(t, tracker.update(ctx))
+	_, ok := tracker.Payments()
+	assert.False(t, ok)
+
+	require.NoError(t, stateCache.Fetch(ctx))
+	require.NoError(t, tracker.update(ctx))
+	payments, ok := tracker.Payments()
+	require.True(t, ok)
+	assert.Equal(t, big.NewInt(80), payments.LinkAvailable)
+
+	require.NoError(t, tracker.Start(ctx))
+	t.Cleanup(func() { require.NoError(t, tracker.Close()) })
+	require.Eventually(t, func() bool {
+		return tracker.HealthReport()[tracker.Name()] != nil
+	}, tests.WaitTimeout(t), 10*time.Millisecond)
+	assert.ErrorIs(t, tracker.HealthReport()[tracker.Name()], ErrInsufficientLinkForPayment)
+}
+
+func TestPaymentsTracker_RoundInterval(t *testing.T) {
+	tracker := NewPaymentsTracker("test-chain-id", solana.PublicKey{}, nil, nil, rpc.CommitmentConfirmed, time.Minute, logger.Test(t))
+	now := time.Now()
+
+	// no round landed since the first observation
+	assert.Zero(t, tracker.roundInterval(10, now))
+	assert.Zero(t, tracker.roundInterval(10, now.Add(time.Minute)))
+
+	assert.Equal(t, 30*time.Second, tracker.roundInterval(14, now.Add(2*time.Minute)))
+
+	// the estimate restarts if the round goes back
+	assert.Zero(t, tracker.roundInterval(1, now.Add(3*time.Minute)))
+	assert.Equal(t, time.Minute, tracker.roundInterval(2, now.Add(4*time.Minute)))
+}
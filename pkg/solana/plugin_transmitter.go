@@ -0,0 +1,57 @@
+package solana
+
+import (
+	"context"
+
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+)
+
+var _ types.ContractTransmitter = (*PluginTransmitter)(nil)
+
+// PluginTransmitter sends the reports of generic OCR2 plugins to the configured program, packed like OCR3 reports.
+// The report context is the OCR2 report context that is signed with the report:
+//
+//	config_digest || 27 zero bytes, epoch (u32 BE), round (u8) || extra_hash
+type PluginTransmitter struct {
+	transmitter *OCR3Transmitter
+	stateCache  *StateCache
+}
+
+func NewPluginTransmitter(transmitter *OCR3Transmitter, stateCache *StateCache) *PluginTransmitter {
+	return &PluginTransmitter{
+		transmitter: transmitter,
+		stateCache:  stateCache,
+	}
+}
+
+// Transmit sends the report to the configured program
+func (c *PluginTransmitter) Transmit(
+	ctx context.Context,
+	reportCtx types.ReportContext,
+	report types.Report,
+	sigs []types.AttributedOnchainSignature,
+) error {
+	rawReportContext := utils.RawReportContext(reportCtx)
+	data := make([]byte, 0, ReportContextLen)
+	for _, word := range rawReportContext {
+		data = append(data, word[:]...)
+	}
+	return c.transmitter.transmit(ctx, data, report, sigs)
+}
+
+func (c *PluginTransmitter) LatestConfigDigestAndEpoch(
+	ctx context.Context,
+) (
+	configDigest types.ConfigDigest,
+	epoch uint32,
+	err error,
+) {
+	state, err := c.stateCache.Read()
+	return state.Config.LatestConfigDigest, state.Config.Epoch, err
+}
+
+func (c *PluginTransmitter) FromAccount(ctx context.Context) (types.Account, error) {
+	return c.transmitter.FromAccount(ctx)
+}
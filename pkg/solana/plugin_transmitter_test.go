@@ -0,0 +1,99 @@
+package solana
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	clientmocks "github.com/smartcontractkit/chainlink-solana/pkg/solana/client/mocks"
+)
+
+func TestPluginTransmitter(t *testing.T) {
+	ctx := tests.Context(t)
+	programID := solana.NewWallet().PublicKey()
+	signer := solana.NewWallet().PublicKey()
+
+	reader := clientmocks.NewReaderWriter(t)
+	reader.On("LatestBlockhash", mock.Anything).Return(&rpc.GetLatestBlockhashResult{
+		Value: &rpc.LatestBlockhashResult{},
+	}, nil).Once()
+
+	txManager := &enqueuedTxs{}
+	ocr3Transmitter, err := NewOCR3Transmitter(OCR3TransmitterConfig{ProgramID: programID.String(), ReportContext: true}, solana.NewWallet().PublicKey(), signer, reader, txManager, logger.Test(t))
+	require.NoError(t, err)
+
+	var state State
+	state.Config.LatestConfigDigest = types.ConfigDigest{1}
+	state.Config.Epoch = 2
+	stateCache := testStateCache(t, func(context.Context) (State, uint64, error) { return state, 0, nil })
+	transmitter := NewPluginTransmitter(ocr3Transmitter, stateCache)
+
+	// state not read yet
+	_, _, err = transmitter.LatestConfigDigestAndEpoch(ctx)
+	require.Error(t, err)
+
+	require.NoError(t, stateCache.Fetch(ctx))
+	digest, epoch, err := transmitter.LatestConfigDigestAndEpoch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.ConfigDigest{1}, digest)
+	assert.Equal(t, uint32(2), epoch)
+
+	// the instruction carries the full report context that the oracles signed
+	reportCtx := types.ReportContext{
+		ReportTimestamp: types.ReportTimestamp{ConfigDigest: digest, Epoch: 1 << 30, Round: 4},
+		ExtraHash:       [32]byte{7, 8, 9},
+	}
+	report := types.Report{6}
+	keyring := &signedMessageKeyring{}
+	sig, err := keyring.Sign(reportCtx, report)
+	require.NoError(t, err)
+	require.NoError(t, transmitter.Transmit(ctx, reportCtx, report, []types.AttributedOnchainSignature{{Signature: sig}}))
+	require.Len(t, txManager.txs, 1)
+
+	data := []byte(txManager.txs[0].Message.Instructions[0].Data)
+	require.Len(t, data, int(ReportContextLen)+4+len(report)+1+len(sig))
+	rawReportContext := data[:ReportContextLen]
+	reportLen := binary.LittleEndian.Uint32(data[ReportContextLen:])
+	onchainReport := data[ReportContextLen+4 : ReportContextLen+4+uint64(reportLen)]
+	assert.Equal(t, keyring.signed, solanaSigData(rawReportContext, onchainReport))
+	assert.Equal(t, byte(1), data[ReportContextLen+4+uint64(reportLen)]) // signature count
+	assert.Equal(t, sig, data[len(data)-len(sig):])
+
+	from, err := transmitter.FromAccount(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Account(signer.String()), from)
+}
+
+// signedMessageKeyring records the message that is signed like the Solana OCR2 onchain keyring, which hashes the
+// report length, the report and the raw report context.
+type signedMessageKeyring struct {
+	types.OnchainKeyring
+	signed []byte
+}
+
+func (k *signedMessageKeyring) Sign(reportCtx types.ReportContext, report types.Report) ([]byte, error) {
+	rawReportContext := utils.RawReportContext(reportCtx)
+	k.signed = solanaSigData(bytes.Join([][]byte{rawReportContext[0][:], rawReportContext[1][:], rawReportContext[2][:]}, nil), report)
+	return bytes.Repeat([]byte{5}, 65), nil
+}
+
+func solanaSigData(rawReportContext []byte, report []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{uint8(len(report))})
+	h.Write(report)
+	h.Write(rawReportContext)
+	return h.Sum(nil)
+}
@@ -5,12 +5,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"math/big"
 
 	"github.com/gagliardetto/solana-go"
 
 	"github.com/smartcontractkit/libocr/offchainreporting2/reportingplugin/median"
 	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"github.com/smartcontractkit/libocr/offchainreporting2plus/ocr3types"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
@@ -142,7 +144,7 @@ func (r *Relayer) NewMedianProvider(ctx context.Context, rargs relaytypes.RelayA
 		return nil, fmt.Errorf("error on 'solana.PublicKeyFromBase58' for 'spec.PluginArgs.TransmissionsID: %w", err)
 	}
 
-	// parse transmissions state account
+	// parse transmissions state account + store program
 	var relayConfig RelayConfig
 	err = json.Unmarshal(rargs.RelayConfig, &relayConfig)
 	if err != nil {
@@ -152,25 +154,69 @@ func (r *Relayer) NewMedianProvider(ctx context.Context, rargs relaytypes.RelayA
 	if err != nil {
 		return nil, fmt.Errorf("error on 'solana.PublicKeyFromBase58' for 'spec.RelayConfig.TransmissionsID: %w", err)
 	}
+	storeProgramID, err := solana.PublicKeyFromBase58(relayConfig.StoreProgramID)
+	if err != nil {
+		return nil, fmt.Errorf("error on 'solana.PublicKeyFromBase58' for 'spec.RelayConfig.StoreProgramID: %w", err)
+	}
 
 	cfg := configWatcher.chain.Config()
-	transmissionsCache := NewTransmissionsCache(transmissionsID, relayConfig.ChainID, cfg, configWatcher.reader, r.lggr)
+	if err = relayConfig.ValidateTxConfigs(cfg); err != nil {
+		return nil, fmt.Errorf("invalid tx config overrides in 'spec.RelayConfig': %w", err)
+	}
+
+	// the feed cache runs the state cache, so that the state account is only read once
+	var feedCache *FeedCache
+	if subscriptions := configWatcher.chain.AccountSubscriptions(); subscriptions != nil {
+		feedCache = NewSubscribedFeedCache(configWatcher.stateCache, transmissionsID, relayConfig.ChainID, cfg, subscriptions, configWatcher.reader, r.lggr)
+	} else {
+		feedCache = NewFeedCache(configWatcher.stateCache, transmissionsID, relayConfig.ChainID, cfg, configWatcher.reader, r.lggr)
+	}
+
+	var pool *TransmitterPool
+	if len(relayConfig.TransmitterIDs) > 0 {
+		keys := []solana.PublicKey{transmitterAccount}
+		for _, id := range relayConfig.TransmitterIDs {
+			key, err := solana.PublicKeyFromBase58(id)
+			if err != nil {
+				return nil, fmt.Errorf("error on 'solana.PublicKeyFromBase58' for 'spec.RelayConfig.TransmitterIDs: %w", err)
+			}
+			if key != transmitterAccount {
+				keys = append(keys, key)
+			}
+		}
+		pool, err = NewTransmitterPool(relayConfig.ChainID, configWatcher.stateID, keys, relayConfig.TransmitterSelection, configWatcher.stateCache, configWatcher.reader, cfg.BalancePollPeriod(), r.lggr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &medianProvider{
-		configProvider:     configWatcher,
-		transmissionsCache: transmissionsCache,
-		reportCodec:        ReportCodec{},
+		configProvider: configWatcher,
+		feedCache:      feedCache,
+		pool:           pool,
+		payments:       NewPaymentsTracker(relayConfig.ChainID, configWatcher.stateID, configWatcher.stateCache, configWatcher.reader, cfg.Commitment(), cfg.BalancePollPeriod(), r.lggr),
+		reportCodec:    ReportCodec{},
 		contract: &MedianContract{
-			stateCache:         configWatcher.stateCache,
-			transmissionsCache: transmissionsCache,
+			stateCache: configWatcher.stateCache,
+			feedCache:  feedCache,
+			programID:  configWatcher.programID,
+			stateID:    configWatcher.stateID,
+			reader:     configWatcher.reader,
+			lggr:       r.lggr,
 		},
 		transmitter: &Transmitter{
 			stateID:            configWatcher.stateID,
 			programID:          configWatcher.programID,
-			storeProgramID:     configWatcher.storeProgramID,
+			storeProgramID:     storeProgramID,
 			transmissionsID:    transmissionsID,
 			transmissionSigner: transmitterAccount,
 			reader:             configWatcher.reader,
 			stateCache:         configWatcher.stateCache,
+			chainID:            relayConfig.ChainID,
+			refreshState:       relayConfig.RefreshStateBeforeTransmit,
+			batcher:            configWatcher.chain.TransmissionBatcher(),
+			txConfigs:          relayConfig.TxConfigs(),
+			pool:               pool,
 			lggr:               r.lggr,
 			txManager:          configWatcher.chain.TxManager(),
 		},
@@ -189,13 +235,13 @@ var _ relaytypes.ConfigProvider = &configProvider{}
 
 type configProvider struct {
 	services.StateMachine
-	chainID                            string
-	programID, storeProgramID, stateID solana.PublicKey
-	stateCache                         *StateCache
-	offchainConfigDigester             types.OffchainConfigDigester
-	configTracker                      types.ContractConfigTracker
-	chain                              Chain
-	reader                             client.Reader
+	chainID                string
+	programID, stateID     solana.PublicKey
+	stateCache             *StateCache
+	offchainConfigDigester types.OffchainConfigDigester
+	configTracker          *ConfigTracker
+	chain                  Chain
+	reader                 client.Reader
 }
 
 func newConfigProvider(_ context.Context, lggr logger.Logger, chain Chain, args relaytypes.RelayArgs) (*configProvider, error) {
@@ -213,10 +259,6 @@ func newConfigProvider(_ context.Context, lggr logger.Logger, chain Chain, args
 	if err != nil {
 		return nil, fmt.Errorf("error on 'solana.PublicKeyFromBase58' for 'spec.RelayConfig.OCR2ProgramID: %w", err)
 	}
-	storeProgramID, err := solana.PublicKeyFromBase58(relayConfig.StoreProgramID)
-	if err != nil {
-		return nil, fmt.Errorf("error on 'solana.PublicKeyFromBase58' for 'spec.RelayConfig.StateID: %w", err)
-	}
 	offchainConfigDigester := OffchainConfigDigester{
 		ProgramID: programID,
 		StateID:   stateID,
@@ -226,15 +268,19 @@ func newConfigProvider(_ context.Context, lggr logger.Logger, chain Chain, args
 	if err != nil {
 		return nil, fmt.Errorf("error in NewMedianProvider.chain.Reader: %w", err)
 	}
-	stateCache := NewStateCache(stateID, relayConfig.ChainID, chain.Config(), reader, lggr)
+	var stateCache *StateCache
+	if subscriptions := chain.AccountSubscriptions(); subscriptions != nil {
+		stateCache = NewSubscribedStateCache(stateID, relayConfig.ChainID, chain.Config(), subscriptions, lggr)
+	} else {
+		stateCache = NewStateCache(stateID, relayConfig.ChainID, chain.Config(), reader, lggr)
+	}
 	return &configProvider{
 		chainID:                relayConfig.ChainID,
 		stateID:                stateID,
 		programID:              programID,
-		storeProgramID:         storeProgramID,
 		stateCache:             stateCache,
 		offchainConfigDigester: offchainConfigDigester,
-		configTracker:          &ConfigTracker{stateCache: stateCache, reader: reader},
+		configTracker:          NewConfigTracker(stateCache, reader, offchainConfigDigester, relayConfig.ChainID),
 		chain:                  chain,
 		reader:                 reader,
 	}, nil
@@ -257,7 +303,9 @@ func (c *configProvider) Close() error {
 }
 
 func (c *configProvider) HealthReport() map[string]error {
-	return map[string]error{c.Name(): c.Healthy()}
+	hp := map[string]error{c.Name(): c.Healthy()}
+	services.CopyHealth(hp, c.configTracker.HealthReport())
+	return hp
 }
 
 func (c *configProvider) OffchainConfigDigester() types.OffchainConfigDigester {
@@ -272,36 +320,51 @@ var _ relaytypes.MedianProvider = &medianProvider{}
 
 type medianProvider struct {
 	*configProvider
-	transmissionsCache *TransmissionsCache
-	reportCodec        median.ReportCodec
-	contract           median.MedianContract
-	transmitter        types.ContractTransmitter
+	feedCache   *FeedCache
+	pool        *TransmitterPool // optional
+	payments    *PaymentsTracker
+	reportCodec median.ReportCodec
+	contract    median.MedianContract
+	transmitter types.ContractTransmitter
 }
 
 func (p *medianProvider) Name() string {
 	return p.stateCache.Name()
 }
 
-// start both cache services
+// start the feed cache, the payments tracker and the transmitter pool
 func (p *medianProvider) Start(ctx context.Context) error {
 	return p.StartOnce("SolanaMedianProvider", func() error {
-		if err := p.configProvider.stateCache.Start(ctx); err != nil {
-			return err
+		var ms services.MultiStart
+		startAll := []services.StartClose{p.feedCache, p.payments}
+		if p.pool != nil {
+			startAll = append(startAll, p.pool)
 		}
-		return p.transmissionsCache.Start(ctx)
+		return ms.Start(ctx, startAll...)
 	})
 }
 
-// close both cache services
+// close the feed cache, the payments tracker and the transmitter pool
 func (p *medianProvider) Close() error {
 	return p.StopOnce("SolanaMedianProvider", func() error {
-		if err := p.configProvider.stateCache.Close(); err != nil {
-			return err
+		closeAll := []io.Closer{p.feedCache, p.payments}
+		if p.pool != nil {
+			closeAll = append(closeAll, p.pool)
 		}
-		return p.transmissionsCache.Close()
+		return services.CloseAll(closeAll...)
 	})
 }
 
+func (p *medianProvider) HealthReport() map[string]error {
+	hp := p.configProvider.HealthReport()
+	services.CopyHealth(hp, p.feedCache.HealthReport())
+	services.CopyHealth(hp, p.payments.HealthReport())
+	if p.pool != nil {
+		services.CopyHealth(hp, p.pool.HealthReport())
+	}
+	return hp
+}
+
 func (p *medianProvider) ContractTransmitter() types.ContractTransmitter {
 	return p.transmitter
 }
@@ -327,9 +390,110 @@ func (p *medianProvider) Codec() relaytypes.Codec {
 }
 
 func (r *Relayer) NewPluginProvider(ctx context.Context, rargs relaytypes.RelayArgs, pargs relaytypes.PluginArgs) (relaytypes.PluginProvider, error) {
-	return nil, errors.New("plugin provider is not supported for solana")
+	lggr := logger.Named(r.lggr, "PluginProvider")
+	configWatcher, err := newConfigProvider(ctx, lggr, r.chain, rargs)
+	if err != nil {
+		return nil, err
+	}
+
+	// parse transmitter account
+	transmitterAccount, err := solana.PublicKeyFromBase58(pargs.TransmitterID)
+	if err != nil {
+		return nil, fmt.Errorf("error on 'solana.PublicKeyFromBase58' for 'spec.PluginArgs.TransmitterID: %w", err)
+	}
+
+	var relayConfig RelayConfig
+	err = json.Unmarshal(rargs.RelayConfig, &relayConfig)
+	if err != nil {
+		return nil, err
+	}
+	if relayConfig.PluginTransmitter == nil {
+		return nil, errors.New("missing 'spec.RelayConfig.PluginTransmitter'")
+	}
+
+	transmitter, err := NewOCR3Transmitter(*relayConfig.PluginTransmitter, configWatcher.stateID, transmitterAccount, configWatcher.reader, configWatcher.chain.TxManager(), lggr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pluginProvider{
+		configProvider: configWatcher,
+		transmitter:    NewPluginTransmitter(transmitter, configWatcher.stateCache),
+	}, nil
+}
+
+var _ relaytypes.PluginProvider = &pluginProvider{}
+
+type pluginProvider struct {
+	*configProvider
+	transmitter *PluginTransmitter
+}
+
+func (p *pluginProvider) ContractTransmitter() types.ContractTransmitter {
+	return p.transmitter
+}
+
+func (p *pluginProvider) ContractReader() relaytypes.ContractReader {
+	return nil
+}
+
+func (p *pluginProvider) Codec() relaytypes.Codec {
+	return nil
 }
 
 func (r *Relayer) NewOCR3CapabilityProvider(ctx context.Context, rargs relaytypes.RelayArgs, pargs relaytypes.PluginArgs) (relaytypes.OCR3CapabilityProvider, error) {
-	return nil, errors.New("ocr3 capability provider is not supported for solana")
+	lggr := logger.Named(r.lggr, "OCR3CapabilityProvider")
+	configWatcher, err := newConfigProvider(ctx, lggr, r.chain, rargs)
+	if err != nil {
+		return nil, err
+	}
+
+	// parse transmitter account
+	transmitterAccount, err := solana.PublicKeyFromBase58(pargs.TransmitterID)
+	if err != nil {
+		return nil, fmt.Errorf("error on 'solana.PublicKeyFromBase58' for 'spec.PluginArgs.TransmitterID: %w", err)
+	}
+
+	var relayConfig RelayConfig
+	err = json.Unmarshal(rargs.RelayConfig, &relayConfig)
+	if err != nil {
+		return nil, err
+	}
+	if relayConfig.OCR3Transmitter == nil {
+		return nil, errors.New("missing 'spec.RelayConfig.OCR3Transmitter'")
+	}
+
+	transmitter, err := NewOCR3Transmitter(*relayConfig.OCR3Transmitter, configWatcher.stateID, transmitterAccount, configWatcher.reader, configWatcher.chain.TxManager(), lggr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ocr3CapabilityProvider{
+		configProvider: configWatcher,
+		transmitter:    transmitter,
+	}, nil
+}
+
+var _ relaytypes.OCR3CapabilityProvider = &ocr3CapabilityProvider{}
+
+type ocr3CapabilityProvider struct {
+	*configProvider
+	transmitter *OCR3Transmitter
+}
+
+func (p *ocr3CapabilityProvider) OCR3ContractTransmitter() ocr3types.ContractTransmitter[[]byte] {
+	return p.transmitter
+}
+
+// ContractTransmitter returns nil, OCR3 capabilities only use the OCR3 contract transmitter
+func (p *ocr3CapabilityProvider) ContractTransmitter() types.ContractTransmitter {
+	return nil
+}
+
+func (p *ocr3CapabilityProvider) ContractReader() relaytypes.ContractReader {
+	return nil
+}
+
+func (p *ocr3CapabilityProvider) Codec() relaytypes.Codec {
+	return nil
 }
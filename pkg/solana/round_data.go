@@ -0,0 +1,256 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/chainreader"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+)
+
+// RoundData is a transmission stored in the live or historical ring buffer of a transmissions account.
+type RoundData struct {
+	RoundID   uint32
+	Answer    *big.Int
+	Timestamp uint32
+	Slot      uint64
+}
+
+// GetRoundData reads the transmission of a round. Rounds in the live ring buffer are returned as is, older
+// rounds are rounded down to the granularity of the historical ring buffer, like the store program does.
+func GetRoundData(ctx context.Context, reader client.AccountReader, account solana.PublicKey, roundID uint32, commitment rpc.CommitmentType) (RoundData, uint64, error) {
+	feed, slot, err := getTransmissionsFeed(ctx, reader, account, commitment)
+	if err != nil {
+		return RoundData{}, 0, err
+	}
+
+	round, err := feed.fetch(roundID)
+	if err != nil {
+		return RoundData{}, 0, err
+	}
+
+	return round, slot, nil
+}
+
+// GetTransmissionsRange reads all stored transmissions of the rounds from `fromRoundID` to `toRoundID`
+// (inclusive) in ascending order. Rounds that are no longer stored are skipped, so only every
+// granularity-th round is returned outside of the live ring buffer.
+func GetTransmissionsRange(ctx context.Context, reader client.AccountReader, account solana.PublicKey, fromRoundID, toRoundID uint32, commitment rpc.CommitmentType) ([]RoundData, uint64, error) {
+	if fromRoundID > toRoundID {
+		return nil, 0, fmt.Errorf("invalid round range: %d > %d", fromRoundID, toRoundID)
+	}
+
+	feed, slot, err := getTransmissionsFeed(ctx, reader, account, commitment)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return feed.rounds(fromRoundID, toRoundID), slot, nil
+}
+
+// RoundDataReader reads rounds of transmissions accounts for chain reader transmissions procedures.
+type RoundDataReader struct {
+	Reader client.AccountReader
+}
+
+var _ chainreader.RoundDataReader = RoundDataReader{}
+
+func (r RoundDataReader) GetRoundData(ctx context.Context, account solana.PublicKey, roundID uint32, commitment rpc.CommitmentType) (chainreader.RoundData, error) {
+	round, _, err := GetRoundData(ctx, r.Reader, account, roundID, commitment)
+	return chainreader.RoundData(round), err
+}
+
+func (r RoundDataReader) GetTransmissionsRange(ctx context.Context, account solana.PublicKey, fromRoundID, toRoundID uint32, commitment rpc.CommitmentType) ([]chainreader.RoundData, error) {
+	rounds, _, err := GetTransmissionsRange(ctx, r.Reader, account, fromRoundID, toRoundID, commitment)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]chainreader.RoundData, len(rounds))
+	for i, round := range rounds {
+		result[i] = chainreader.RoundData(round)
+	}
+	return result, nil
+}
+
+// getTransmissionsFeed reads the whole transmissions account, so that both ring buffers are read at the same slot.
+func getTransmissionsFeed(ctx context.Context, reader client.AccountReader, account solana.PublicKey, commitment rpc.CommitmentType) (transmissionsFeed, uint64, error) {
+	res, err := reader.GetAccountInfoWithOpts(ctx, account, &rpc.GetAccountInfoOpts{
+		Encoding:       "base64",
+		Commitment:     commitment,
+		MinContextSlot: client.MinContextSlot(ctx),
+	})
+	if err != nil {
+		return transmissionsFeed{}, 0, fmt.Errorf("error on rpc.GetAccountInfo [transmissions]: %w", err)
+	}
+
+	// check for nil pointers
+	if res == nil || res.Value == nil || res.Value.Data == nil {
+		return transmissionsFeed{}, 0, errors.New("nil pointer returned in getTransmissionsFeed.GetAccountInfoWithOpts")
+	}
+
+	feed, err := decodeTransmissionsFeed(res.Value.Data.GetBinary())
+	if err != nil {
+		return transmissionsFeed{}, 0, err
+	}
+
+	return feed, res.RPCContext.Context.Slot, nil
+}
+
+// transmissionsFeed holds the header and the raw ring buffers of a transmissions account.
+type transmissionsFeed struct {
+	header     TransmissionsHeader
+	live       []byte
+	historical []byte
+}
+
+func decodeTransmissionsFeed(data []byte) (transmissionsFeed, error) {
+	headerEnd := AccountDiscriminatorLen + TransmissionsHeaderLen
+	if uint64(len(data)) < headerEnd {
+		return transmissionsFeed{}, fmt.Errorf("transmissions account data too short for header: %d bytes", len(data))
+	}
+
+	header, err := decodeTransmissionsHeader(data[AccountDiscriminatorLen:headerEnd])
+	if err != nil {
+		return transmissionsFeed{}, err
+	}
+
+	liveStart := AccountDiscriminatorLen + TransmissionsHeaderMaxSize
+	liveEnd := liveStart + uint64(header.LiveLength)*TransmissionLen
+	if header.LiveLength == 0 || uint64(len(data)) < liveEnd {
+		return transmissionsFeed{}, fmt.Errorf("transmissions account data too short for %d live transmissions: %d bytes", header.LiveLength, len(data))
+	}
+
+	// the historical ring buffer takes the remaining space
+	historicalLen := (uint64(len(data)) - liveEnd) / TransmissionLen
+
+	return transmissionsFeed{
+		header:     header,
+		live:       data[liveStart:liveEnd],
+		historical: data[liveEnd : liveEnd+historicalLen*TransmissionLen],
+	}, nil
+}
+
+func (f transmissionsFeed) liveLen() uint32 {
+	return uint32(uint64(len(f.live)) / TransmissionLen)
+}
+
+func (f transmissionsFeed) historicalLen() uint32 {
+	return uint32(uint64(len(f.historical)) / TransmissionLen)
+}
+
+// liveStart returns the oldest round of the live ring buffer.
+func (f transmissionsFeed) liveStart() uint32 {
+	return saturatingSub(f.header.LatestRoundID, f.liveLen()-1)
+}
+
+// historicalRange returns the oldest and latest rounds of the historical ring buffer.
+func (f transmissionsFeed) historicalRange() (start, end uint32, ok bool) {
+	granularity := uint32(f.header.Granularity)
+	if f.historicalLen() == 0 || granularity == 0 {
+		return 0, 0, false
+	}
+
+	end = f.header.LatestRoundID - f.header.LatestRoundID%granularity
+	start = saturatingSub(end, granularity*(f.historicalLen()-1))
+
+	return start, end, true
+}
+
+// fetch mirrors the lookup of the store program.
+func (f transmissionsFeed) fetch(roundID uint32) (RoundData, error) {
+	latest := f.header.LatestRoundID
+	if roundID == 0 || roundID > latest {
+		return RoundData{}, fmt.Errorf("round %d not found, latest round is %d", roundID, latest)
+	}
+
+	if roundID >= f.liveStart() {
+		offset := latest - roundID + 1 // the latest round is the element before the cursor
+		return f.transmission(f.live, ringIndex(f.header.LiveCursor, offset, f.liveLen()), roundID)
+	}
+
+	start, end, ok := f.historicalRange()
+	if ok && start <= roundID && roundID <= end {
+		granularity := uint32(f.header.Granularity)
+		roundID -= roundID % granularity
+		offset := (end-roundID)/granularity + 1
+		return f.transmission(f.historical, ringIndex(f.header.HistoricalCursor, offset, f.historicalLen()), roundID)
+	}
+
+	return RoundData{}, fmt.Errorf("round %d is no longer stored", roundID)
+}
+
+// rounds returns the stored rounds in the range in ascending order.
+func (f transmissionsFeed) rounds(from, to uint32) []RoundData {
+	if to > f.header.LatestRoundID {
+		to = f.header.LatestRoundID
+	}
+
+	// skip rounds older than both ring buffers
+	oldest := f.liveStart()
+	if start, _, ok := f.historicalRange(); ok && start < oldest {
+		oldest = start
+	}
+	if from < oldest {
+		from = oldest
+	}
+	if from == 0 {
+		from = 1
+	}
+
+	var rounds []RoundData
+	for roundID := uint64(from); roundID <= uint64(to); roundID++ {
+		// historical rounds are only stored for multiples of the granularity
+		if uint32(roundID) < f.liveStart() && uint32(roundID)%uint32(f.header.Granularity) != 0 {
+			continue
+		}
+
+		round, err := f.fetch(uint32(roundID))
+		if err != nil {
+			continue
+		}
+
+		rounds = append(rounds, round)
+	}
+
+	return rounds
+}
+
+func (f transmissionsFeed) transmission(ring []byte, index uint32, roundID uint32) (RoundData, error) {
+	offset := uint64(index) * TransmissionLen
+
+	var t Transmission
+	if err := bin.NewBinDecoder(ring[offset : offset+TransmissionLen]).Decode(&t); err != nil {
+		return RoundData{}, fmt.Errorf("failed to decode transmission: %w", err)
+	}
+
+	return RoundData{
+		RoundID:   roundID,
+		Answer:    t.Answer.BigInt(),
+		Timestamp: t.Timestamp,
+		Slot:      t.Slot,
+	}, nil
+}
+
+// ringIndex returns the index of the element `offset` positions before the cursor.
+func ringIndex(cursor, offset, length uint32) uint32 {
+	if offset <= cursor {
+		return cursor - offset
+	}
+
+	return length - (offset - cursor)
+}
+
+func saturatingSub(a, b uint32) uint32 {
+	if b > a {
+		return 0
+	}
+
+	return a - b
+}
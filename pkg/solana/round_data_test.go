@@ -0,0 +1,156 @@
+package solana
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	bin "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+)
+
+// testTransmissionsAccount mirrors the store program test: 20 rounds with a live length of 2, a historical
+// length of 3 and a granularity of 5.
+func testTransmissionsAccount(t *testing.T) []byte {
+	const liveLength, historicalLength, granularity = 2, 3, 5
+
+	header := TransmissionsHeader{Version: 2, Granularity: granularity, LiveLength: liveLength}
+	live := make([]Transmission, liveLength)
+	historical := make([]Transmission, historicalLength)
+
+	for i := uint32(1); i <= 20; i++ {
+		transmission := Transmission{Slot: uint64(i), Timestamp: i, Answer: bin.Int128{Lo: uint64(i)}}
+
+		header.LatestRoundID++
+		live[header.LiveCursor] = transmission
+		header.LiveCursor = (header.LiveCursor + 1) % liveLength
+
+		if header.LatestRoundID%granularity == 0 {
+			historical[header.HistoricalCursor] = transmission
+			header.HistoricalCursor = (header.HistoricalCursor + 1) % historicalLength
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := bin.NewBinEncoder(&buf)
+	require.NoError(t, encoder.WriteBytes(make([]byte, AccountDiscriminatorLen), false))
+	require.NoError(t, encoder.Encode(header))
+	require.NoError(t, encoder.WriteBytes(make([]byte, TransmissionsHeaderMaxSize-TransmissionsHeaderLen), false))
+	for _, transmission := range append(live, historical...) {
+		require.NoError(t, encoder.Encode(transmission))
+	}
+
+	return buf.Bytes()
+}
+
+func testTransmissionsServer(t *testing.T, data []byte) string {
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		value := base64.StdEncoding.EncodeToString(data)
+		res := fmt.Sprintf(`{"jsonrpc":"2.0","result":{"context": {"slot":21},"value": {"data":["%s","base64"],"executable": false,"lamports": 1000000000,"owner": "11111111111111111111111111111111","rentEpoch":2}},"id":1}`, value)
+		_, err := w.Write([]byte(res))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(mockServer.Close)
+
+	return mockServer.URL
+}
+
+func TestGetRoundData(t *testing.T) {
+	reader := testSetupReader(t, testTransmissionsServer(t, testTransmissionsAccount(t)))
+
+	for _, tc := range []struct {
+		name     string
+		roundID  uint32
+		expected uint32
+		err      string
+	}{
+		{name: "latest live round", roundID: 20, expected: 20},
+		{name: "oldest live round", roundID: 19, expected: 19},
+		{name: "historical round rounds down", roundID: 18, expected: 15},
+		{name: "historical round", roundID: 15, expected: 15},
+		{name: "oldest historical round", roundID: 10, expected: 10},
+		{name: "round no longer stored", roundID: 9, err: "no longer stored"},
+		{name: "future round", roundID: 21, err: "not found"},
+		{name: "round zero", roundID: 0, err: "not found"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			round, slot, err := GetRoundData(tests.Context(t), reader, solana.PublicKey{}, tc.roundID, "")
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, uint64(21), slot)
+			assert.Equal(t, tc.expected, round.RoundID)
+			assert.Equal(t, int64(tc.expected), round.Answer.Int64())
+			assert.Equal(t, tc.expected, round.Timestamp)
+			assert.Equal(t, uint64(tc.expected), round.Slot)
+		})
+	}
+}
+
+func TestGetTransmissionsRange(t *testing.T) {
+	reader := testSetupReader(t, testTransmissionsServer(t, testTransmissionsAccount(t)))
+
+	roundIDs := func(rounds []RoundData) (ids []uint32) {
+		for _, round := range rounds {
+			ids = append(ids, round.RoundID)
+		}
+		return ids
+	}
+
+	rounds, slot, err := GetTransmissionsRange(tests.Context(t), reader, solana.PublicKey{}, 0, 100, "")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(21), slot)
+	assert.Equal(t, []uint32{10, 15, 19, 20}, roundIDs(rounds))
+
+	rounds, _, err = GetTransmissionsRange(tests.Context(t), reader, solana.PublicKey{}, 11, 19, "")
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{15, 19}, roundIDs(rounds))
+
+	_, _, err = GetTransmissionsRange(tests.Context(t), reader, solana.PublicKey{}, 20, 19, "")
+	require.Error(t, err)
+}
+
+func TestRoundDataReader(t *testing.T) {
+	reader := RoundDataReader{Reader: testSetupReader(t, testTransmissionsServer(t, testTransmissionsAccount(t)))}
+
+	round, err := reader.GetRoundData(tests.Context(t), solana.PublicKey{}, 18, "")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(15), round.RoundID)
+	assert.Equal(t, int64(15), round.Answer.Int64())
+
+	rounds, err := reader.GetTransmissionsRange(tests.Context(t), solana.PublicKey{}, 11, 19, "")
+	require.NoError(t, err)
+	require.Len(t, rounds, 2)
+	assert.Equal(t, uint32(19), rounds[1].RoundID)
+
+	_, err = reader.GetTransmissionsRange(tests.Context(t), solana.PublicKey{}, 20, 19, "")
+	require.Error(t, err)
+}
+
+func TestDecodeTransmissionsFeed(t *testing.T) {
+	data := testTransmissionsAccount(t)
+
+	// the latest transmission decoder agrees with the ring buffer
+	answer, err := DecodeLatestTransmission(data)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), answer.Data.Int64())
+
+	// fail if the live ring buffer is truncated
+	_, err = decodeTransmissionsFeed(data[:AccountDiscriminatorLen+TransmissionsHeaderMaxSize+TransmissionLen])
+	require.Error(t, err)
+
+	// without historical ring buffer only live rounds are stored
+	feed, err := decodeTransmissionsFeed(data[:AccountDiscriminatorLen+TransmissionsHeaderMaxSize+2*TransmissionLen])
+	require.NoError(t, err)
+	assert.Len(t, feed.rounds(1, 20), 2)
+}
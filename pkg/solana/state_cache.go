@@ -31,10 +31,17 @@ func NewStateCache(stateID solana.PublicKey, chainID string, cfg config.Config,
 	return &StateCache{client.NewCache(name, stateID, chainID, cfg, getter, logger.With(lggr, "cache", name))}
 }
 
+// NewSubscribedStateCache creates a state cache that is updated by an account subscription.
+func NewSubscribedStateCache(stateID solana.PublicKey, chainID string, cfg config.Config, subscriptions *client.AccountSubscriptions, lggr logger.Logger) *StateCache {
+	name := "ocr2_median_state"
+	return &StateCache{client.NewSubscribedCache(name, stateID, chainID, cfg, subscriptions, DecodeState, logger.With(lggr, "cache", name))}
+}
+
 func GetState(ctx context.Context, reader client.AccountReader, account solana.PublicKey, commitment rpc.CommitmentType) (State, uint64, error) {
 	res, err := reader.GetAccountInfoWithOpts(ctx, account, &rpc.GetAccountInfoOpts{
-		Commitment: commitment,
-		Encoding:   "base64",
+		Commitment:     commitment,
+		Encoding:       "base64",
+		MinContextSlot: client.MinContextSlot(ctx),
 	})
 	if err != nil {
 		return State{}, 0, fmt.Errorf("failed to fetch state account at address '%s': %w", account.String(), err)
@@ -45,16 +52,26 @@ func GetState(ctx context.Context, reader client.AccountReader, account solana.P
 		return State{}, 0, errors.New("nil pointer returned in GetState.GetAccountInfoWithOpts")
 	}
 
+	state, err := DecodeState(res.Value.Data.GetBinary())
+	if err != nil {
+		return State{}, 0, err
+	}
+
+	blockNum := res.RPCContext.Context.Slot
+	return state, blockNum, nil
+}
+
+// DecodeState decodes the data of a state account and validates its config version.
+func DecodeState(data []byte) (State, error) {
 	var state State
-	if err := bin.NewBinDecoder(res.Value.Data.GetBinary()).Decode(&state); err != nil {
-		return State{}, 0, fmt.Errorf("failed to decode state account data: %w", err)
+	if err := bin.NewBinDecoder(data).Decode(&state); err != nil {
+		return State{}, fmt.Errorf("failed to decode state account data: %w", err)
 	}
 
 	// validation for config version
 	if configVersion != state.Version {
-		return State{}, 0, fmt.Errorf("decoded config version (%d) does not match expected config version (%d)", state.Version, configVersion)
+		return State{}, fmt.Errorf("decoded config version (%d) does not match expected config version (%d)", state.Version, configVersion)
 	}
 
-	blockNum := res.RPCContext.Context.Slot
-	return state, blockNum, nil
+	return state, nil
 }
@@ -0,0 +1,392 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/fees"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/monitor"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/txm"
+)
+
+const (
+	// maxTxSize is the maximum size of a serialized transaction
+	maxTxSize = 1232
+	// maxComputeUnitLimit is the maximum compute unit limit of a transaction
+	maxComputeUnitLimit = 1_400_000
+)
+
+// TransmissionBatcher packs the transmit instructions of feeds that share a transmitter into a single
+// tx, so they share the base fee and compute budget overhead. Reports are collected for a short window.
+// Feeds whose instruction fails simulation are split out of the batch and sent in their own tx. If a
+// batch reverts or is cancelled because some of its feeds are superseded, the remaining feeds are
+// simulated and sent again once.
+type TransmissionBatcher struct {
+	services.StateMachine
+	chainID   string
+	cfg       config.Config
+	client    func() (client.ReaderWriter, error)
+	txManager TxManager
+	lggr      logger.Logger
+
+	lock    sync.Mutex
+	pending map[solana.PublicKey][]*batchedTransmission // by transmitter
+
+	stopCh services.StopChan
+	wg     sync.WaitGroup
+}
+
+type batchedTransmission struct {
+	stateID     solana.PublicKey
+	instruction solana.Instruction
+	superseded  func(ctx context.Context) bool
+	result      chan error
+}
+
+func NewTransmissionBatcher(chainID string, cfg config.Config, client func() (client.ReaderWriter, error), txManager TxManager, lggr logger.Logger) *TransmissionBatcher {
+	return &TransmissionBatcher{
+		chainID:   chainID,
+		cfg:       cfg,
+		client:    client,
+		txManager: txManager,
+		lggr:      logger.Named(lggr, "TransmissionBatcher"),
+		pending:   map[solana.PublicKey][]*batchedTransmission{},
+		stopCh:    make(chan struct{}),
+	}
+}
+
+func (b *TransmissionBatcher) Name() string {
+	return b.lggr.Name()
+}
+
+func (b *TransmissionBatcher) Start(context.Context) error {
+	return b.StartOnce("TransmissionBatcher", func() error { return nil })
+}
+
+func (b *TransmissionBatcher) Close() error {
+	return b.StopOnce("TransmissionBatcher", func() error {
+		b.lock.Lock()
+		close(b.stopCh)
+		b.lock.Unlock()
+		b.wg.Wait()
+		return nil
+	})
+}
+
+func (b *TransmissionBatcher) HealthReport() map[string]error {
+	return map[string]error{b.Name(): b.Healthy()}
+}
+
+// Transmit adds the transmit instruction of a feed to the next batch of the transmitter and waits until
+// the batch is enqueued in the txm.
+func (b *TransmissionBatcher) Transmit(ctx context.Context, transmitter, stateID solana.PublicKey, instruction solana.Instruction, superseded func(ctx context.Context) bool) error {
+	if err := b.Ready(); err != nil {
+		return fmt.Errorf("error in TransmissionBatcher.Transmit: %w", err)
+	}
+
+	t := &batchedTransmission{
+		stateID:     stateID,
+		instruction: instruction,
+		superseded:  superseded,
+		result:      make(chan error, 1),
+	}
+
+	b.lock.Lock()
+	select {
+	case <-b.stopCh:
+		b.lock.Unlock()
+		return errors.New("error in TransmissionBatcher.Transmit: stopped")
+	default:
+	}
+	// the first transmission starts the window of the batch
+	if len(b.pending[transmitter]) == 0 {
+		b.wg.Add(1)
+		go b.collect(transmitter)
+	}
+	b.pending[transmitter] = append(b.pending[transmitter], t)
+	b.lock.Unlock()
+
+	select {
+	case err := <-t.result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// collect waits for the batch window and sends all transmissions collected for the transmitter
+func (b *TransmissionBatcher) collect(transmitter solana.PublicKey) {
+	defer b.wg.Done()
+	ctx, cancel := b.stopCh.NewCtx()
+	defer cancel()
+
+	select {
+	case <-time.After(b.cfg.OCR2TransmitBatchWindow()):
+	case <-ctx.Done():
+	}
+
+	b.lock.Lock()
+	transmissions := b.pending[transmitter]
+	delete(b.pending, transmitter)
+	b.lock.Unlock()
+
+	if ctx.Err() != nil {
+		setResult(transmissions, errors.New("transmission batcher stopped"))
+		return
+	}
+
+	for len(transmissions) > 0 {
+		batch := b.pack(transmitter, transmissions)
+		transmissions = transmissions[len(batch):]
+		b.send(ctx, transmitter, batch, false)
+	}
+}
+
+// pack returns the longest prefix of the transmissions that fits into a single tx
+func (b *TransmissionBatcher) pack(transmitter solana.PublicKey, transmissions []*batchedTransmission) []*batchedTransmission {
+	n := 1 // a single transmission is sent as is
+	for ; n < len(transmissions); n++ {
+		if !b.fits(transmitter, transmissions[:n+1]) {
+			break
+		}
+	}
+	return transmissions[:n]
+}
+
+func (b *TransmissionBatcher) fits(transmitter solana.PublicKey, batch []*batchedTransmission) bool {
+	if b.computeUnitLimit(batch) > maxComputeUnitLimit {
+		return false
+	}
+
+	tx, err := newBatchTx(transmitter, solana.Hash{}, batch)
+	if err != nil {
+		return false
+	}
+
+	// additional components that transaction manager adds to the transaction
+	if fees.SetComputeUnitPrice(tx, 0) != nil || fees.SetComputeUnitLimit(tx, 0) != nil {
+		return false
+	}
+	tx.Signatures = make([]solana.Signature, tx.Message.Header.NumRequiredSignatures)
+
+	data, err := tx.MarshalBinary()
+	return err == nil && len(data) <= maxTxSize
+}
+
+// computeUnitLimit returns the default compute unit limit for each instruction of the batch
+func (b *TransmissionBatcher) computeUnitLimit(batch []*batchedTransmission) uint64 {
+	return uint64(b.cfg.ComputeUnitLimitDefault()) * uint64(len(batch))
+}
+
+// send simulates the batch and splits out transmissions that fail before enqueueing it. Resent batches
+// are not resent again if they fail.
+func (b *TransmissionBatcher) send(ctx context.Context, transmitter solana.PublicKey, batch []*batchedTransmission, resent bool) {
+	c, blockhash, err := b.latestBlockhash(ctx)
+	if err != nil {
+		setResult(batch, err)
+		return
+	}
+
+	for len(batch) > 1 {
+		failed, err := b.simulate(ctx, c, transmitter, blockhash, batch)
+		if err != nil {
+			b.lggr.Warnw("Failed to simulate batch, sending transmissions separately", "transmitter", transmitter, "err", err)
+			for _, t := range batch {
+				b.enqueue(ctx, transmitter, blockhash, []*batchedTransmission{t}, "split")
+			}
+			return
+		}
+		if failed < 0 {
+			break
+		}
+
+		// retry the failing transmission in its own tx, so it does not revert the others
+		b.lggr.Warnw("Transmission failed in batch simulation, splitting it out", "transmitter", transmitter, "state", batch[failed].stateID)
+		b.enqueue(ctx, transmitter, blockhash, batch[failed:failed+1], "split")
+		batch = append(batch[:failed:failed], batch[failed+1:]...)
+	}
+
+	status := "batched"
+	switch {
+	case resent:
+		status = "resent"
+	case len(batch) == 1:
+		status = "single"
+	}
+	b.enqueue(ctx, transmitter, blockhash, batch, status)
+}
+
+// latestBlockhash returns the client and the blockhash to build txs with
+func (b *TransmissionBatcher) latestBlockhash(ctx context.Context) (client.ReaderWriter, solana.Hash, error) {
+	c, err := b.client()
+	if err != nil {
+		return nil, solana.Hash{}, fmt.Errorf("error on TransmissionBatcher.client: %w", err)
+	}
+
+	blockhash, err := c.LatestBlockhash(ctx)
+	if err != nil {
+		return nil, solana.Hash{}, fmt.Errorf("error on Transmit.GetRecentBlockhash: %w", err)
+	}
+	if blockhash == nil || blockhash.Value == nil {
+		return nil, solana.Hash{}, errors.New("nil pointer returned from Transmit.GetRecentBlockhash")
+	}
+	return c, blockhash.Value.Blockhash, nil
+}
+
+// simulate returns the index of the transmission that fails or -1 if there is none
+func (b *TransmissionBatcher) simulate(ctx context.Context, c client.ReaderWriter, transmitter solana.PublicKey, blockhash solana.Hash, batch []*batchedTransmission) (int, error) {
+	tx, err := newBatchTx(transmitter, blockhash, batch)
+	if err != nil {
+		return -1, err
+	}
+	// signatures are not verified but required
+	tx.Signatures = make([]solana.Signature, tx.Message.Header.NumRequiredSignatures)
+
+	res, err := c.SimulateTx(ctx, tx, &rpc.SimulateTransactionOpts{Commitment: b.cfg.Commitment()})
+	if err != nil {
+		return -1, err
+	}
+
+	failed, ok := instructionErrorIndex(res.Err)
+	if !ok || failed >= len(batch) {
+		if res.Err != nil {
+			b.lggr.Debugw("Batch simulation failed without instruction error", "transmitter", transmitter, "err", res.Err)
+		}
+		return -1, nil
+	}
+	return failed, nil
+}
+
+func (b *TransmissionBatcher) enqueue(ctx context.Context, transmitter solana.PublicKey, blockhash solana.Hash, batch []*batchedTransmission, status string) {
+	states := make([]string, len(batch))
+	for i, t := range batch {
+		states[i] = t.stateID.String()
+		monitor.IncBatchedTransmissions(b.chainID, states[i], status)
+	}
+
+	tx, err := newBatchTx(transmitter, blockhash, batch)
+	if err != nil {
+		setResult(batch, fmt.Errorf("error on Transmit.NewTransaction: %w", err))
+		return
+	}
+
+	// a single stale report reverts the whole tx, so stop retrying once any of them is superseded
+	superseded := func(ctx context.Context) bool {
+		for _, t := range batch {
+			if t.superseded(ctx) {
+				return true
+			}
+		}
+		return false
+	}
+	txCfgs := []txm.SetTxConfig{txm.SetSuperseded(superseded)}
+	if len(batch) > 1 {
+		if status != "resent" {
+			txCfgs = append(txCfgs, txm.SetOnError(func(errType int) {
+				b.resend(transmitter, batch, errType)
+			}))
+		}
+		if limit := b.computeUnitLimit(batch); limit > 0 {
+			txCfgs = append(txCfgs, txm.SetComputeUnitLimit(uint32(limit))) //nolint:gosec // limited to maxComputeUnitLimit when packing
+		}
+	}
+
+	b.lggr.Debugw("Queuing batched transmit tx", "transmitter", transmitter, "states", states)
+	if err = b.txManager.Enqueue(ctx, transmitter.String(), tx, txCfgs...); err != nil {
+		err = fmt.Errorf("error on Transmit.txManager.Enqueue: %w", err)
+	}
+	setResult(batch, err)
+}
+
+// resend sends the transmissions of a reverted or cancelled batch that are not superseded yet. They are
+// simulated again, so that a transmission that reverted the batch is split out.
+func (b *TransmissionBatcher) resend(transmitter solana.PublicKey, batch []*batchedTransmission, errType int) {
+	switch errType {
+	case txm.TxFailRevert, txm.TxFailSimRevert, txm.TxCancelSuperseded:
+	default:
+		return // dropped or failed for other reasons, the transmissions would fail the same way
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	select {
+	case <-b.stopCh:
+		return
+	default:
+	}
+
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		ctx, cancel := b.stopCh.NewCtx()
+		defer cancel()
+
+		var remaining []*batchedTransmission
+		for _, t := range batch {
+			// the batch did not land, so superseded feeds were landed by other txs
+			if t.superseded(ctx) {
+				continue
+			}
+			remaining = append(remaining, &batchedTransmission{
+				stateID:     t.stateID,
+				instruction: t.instruction,
+				superseded:  t.superseded,
+				result:      make(chan error, 1),
+			})
+		}
+		if len(remaining) == 0 {
+			return
+		}
+
+		b.lggr.Infow("Resending transmissions of batch", "transmitter", transmitter, "count", len(remaining), "errType", errType)
+		b.send(ctx, transmitter, remaining, true)
+		for _, t := range remaining {
+			if err := <-t.result; err != nil {
+				b.lggr.Errorw("Failed to resend transmission of batch", "transmitter", transmitter, "state", t.stateID, "err", err)
+			}
+		}
+	}()
+}
+
+func newBatchTx(transmitter solana.PublicKey, blockhash solana.Hash, batch []*batchedTransmission) (*solana.Transaction, error) {
+	instructions := make([]solana.Instruction, len(batch))
+	for i, t := range batch {
+		instructions[i] = t.instruction
+	}
+	return solana.NewTransaction(instructions, blockhash, solana.TransactionPayer(transmitter))
+}
+
+func setResult(batch []*batchedTransmission, err error) {
+	for _, t := range batch {
+		t.result <- err
+	}
+}
+
+// instructionErrorIndex parses the index of the failing instruction from a transaction error,
+// e.g. {"InstructionError":[1,{"Custom":1}]}
+func instructionErrorIndex(txErr interface{}) (int, bool) {
+	m, ok := txErr.(map[string]interface{})
+	if !ok {
+		return 0, false
+	}
+	v, ok := m["InstructionError"].([]interface{})
+	if !ok || len(v) == 0 {
+		return 0, false
+	}
+	index, ok := v[0].(float64)
+	if !ok || index < 0 {
+		return 0, false
+	}
+	return int(index), true
+}
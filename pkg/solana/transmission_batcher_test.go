@@ -0,0 +1,255 @@
+package solana
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/config"
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+	clientmocks "github.com/smartcontractkit/chainlink-solana/pkg/solana/client/mocks"
+	solcfg "github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/txm"
+)
+
+func TestTransmissionBatcher(t *testing.T) {
+	transmitter := solana.NewWallet().PublicKey()
+	programID := solana.NewWallet().PublicKey()
+
+	cfg := solcfg.NewDefault()
+	cfg.Chain.OCR2TransmitBatchWindow = config.MustNewDuration(50 * time.Millisecond)
+
+	instruction := func(stateID solana.PublicKey, dataLen int) solana.Instruction {
+		return solana.NewInstruction(programID, []*solana.AccountMeta{
+			{PublicKey: stateID, IsWritable: true},
+			{PublicKey: transmitter, IsSigner: true},
+		}, make([]byte, dataLen))
+	}
+	notSuperseded := func(context.Context) bool { return false }
+
+	setup := func(t *testing.T, simulate func(tx *solana.Transaction) interface{}) (*TransmissionBatcher, *enqueuedTxs) {
+		rw := clientmocks.NewReaderWriter(t)
+		rw.On("LatestBlockhash", mock.Anything).Return(&rpc.GetLatestBlockhashResult{
+			Value: &rpc.LatestBlockhashResult{},
+		}, nil).Maybe()
+		rw.On("SimulateTx", mock.Anything, mock.Anything, mock.Anything).Return(
+			func(_ context.Context, tx *solana.Transaction, _ *rpc.SimulateTransactionOpts) *rpc.SimulateTransactionResult {
+				return &rpc.SimulateTransactionResult{Err: simulate(tx)}
+			}, nil,
+		).Maybe()
+
+		txManager := &enqueuedTxs{}
+		batcher := NewTransmissionBatcher("test-chain-id", cfg, func() (client.ReaderWriter, error) { return rw, nil }, txManager, logger.Test(t))
+		require.NoError(t, batcher.Start(tests.Context(t)))
+		t.Cleanup(func() { require.NoError(t, batcher.Close()) })
+		return batcher, txManager
+	}
+
+	// transmit sends all transmissions concurrently and returns the per feed errors
+	transmit := func(t *testing.T, batcher *TransmissionBatcher, instructions map[solana.PublicKey]solana.Instruction, landed ...*sync.Map) map[solana.PublicKey]error {
+		var wg sync.WaitGroup
+		var lock sync.Mutex
+		errs := map[solana.PublicKey]error{}
+		for stateID, instruction := range instructions {
+			wg.Add(1)
+			superseded := notSuperseded
+			if len(landed) > 0 {
+				superseded = func(context.Context) bool {
+					_, ok := landed[0].Load(stateID)
+					return ok
+				}
+			}
+			go func(stateID solana.PublicKey, instruction solana.Instruction) {
+				defer wg.Done()
+				err := batcher.Transmit(tests.Context(t), transmitter, stateID, instruction, superseded)
+				lock.Lock()
+				errs[stateID] = err
+				lock.Unlock()
+			}(stateID, instruction)
+		}
+		wg.Wait()
+		return errs
+	}
+
+	t.Run("batches transmissions", func(t *testing.T) {
+		batcher, txManager := setup(t, func(*solana.Transaction) interface{} { return nil })
+
+		stateA, stateB := solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey()
+		errs := transmit(t, batcher, map[solana.PublicKey]solana.Instruction{
+			stateA: instruction(stateA, 100),
+			stateB: instruction(stateB, 100),
+		})
+		assert.NoError(t, errs[stateA])
+		assert.NoError(t, errs[stateB])
+
+		require.Len(t, txManager.txs, 1)
+		assert.Len(t, txManager.txs[0].Message.Instructions, 2)
+		assert.Equal(t, 2*cfg.ComputeUnitLimitDefault(), txManager.cfgs[0].ComputeUnitLimit)
+		require.NotNil(t, txManager.cfgs[0].Superseded)
+		assert.False(t, txManager.cfgs[0].Superseded(tests.Context(t)))
+		assert.NotNil(t, txManager.cfgs[0].OnError)
+	})
+
+	t.Run("resends remaining transmissions of a failed batch", func(t *testing.T) {
+		for _, errType := range []int{txm.TxFailRevert, txm.TxFailSimRevert, txm.TxCancelSuperseded} {
+			batcher, txManager := setup(t, func(*solana.Transaction) interface{} { return nil })
+
+			var landed sync.Map
+			stateA, stateB, stateC := solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey()
+			transmit(t, batcher, map[solana.PublicKey]solana.Instruction{
+				stateA: instruction(stateA, 100),
+				stateB: instruction(stateB, 100),
+				stateC: instruction(stateC, 100),
+			}, &landed)
+			require.Len(t, txManager.txs, 1)
+
+			// the report of stateB was landed by another tx
+			landed.Store(stateB, struct{}{})
+			require.True(t, txManager.cfgs[0].Superseded(tests.Context(t)))
+			txManager.cfgs[0].OnError(errType)
+			batcher.wg.Wait()
+
+			// the remaining transmissions are batched again, but not resent a second time
+			require.Len(t, txManager.txs, 2)
+			var resent []solana.PublicKey
+			for _, ix := range txManager.txs[1].Message.Instructions {
+				resent = append(resent, txManager.txs[1].Message.AccountKeys[ix.Accounts[0]])
+			}
+			assert.ElementsMatch(t, []solana.PublicKey{stateA, stateC}, resent)
+			assert.Nil(t, txManager.cfgs[1].OnError)
+		}
+	})
+
+	t.Run("splits the transmission that reverted a batch when resending", func(t *testing.T) {
+		var failing sync.Map
+		batcher, txManager := setup(t, func(tx *solana.Transaction) interface{} {
+			for i, ix := range tx.Message.Instructions {
+				if _, ok := failing.Load(tx.Message.AccountKeys[ix.Accounts[0]]); ok {
+					return map[string]interface{}{"InstructionError": []interface{}{float64(i), "Custom"}}
+				}
+			}
+			return nil
+		})
+
+		stateA, stateB := solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey()
+		transmit(t, batcher, map[solana.PublicKey]solana.Instruction{
+			stateA: instruction(stateA, 100),
+			stateB: instruction(stateB, 100),
+		})
+		require.Len(t, txManager.txs, 1)
+
+		// the transmission of stateA reverts on-chain after the batch passed simulation
+		failing.Store(stateA, struct{}{})
+		txManager.cfgs[0].OnError(txm.TxFailRevert)
+		batcher.wg.Wait()
+
+		require.Len(t, txManager.txs, 3)
+		for _, tx := range txManager.txs[1:] {
+			require.Len(t, tx.Message.Instructions, 1)
+		}
+		assert.Equal(t, stateA, txManager.txs[1].Message.AccountKeys[txManager.txs[1].Message.Instructions[0].Accounts[0]])
+		assert.Equal(t, stateB, txManager.txs[2].Message.AccountKeys[txManager.txs[2].Message.Instructions[0].Accounts[0]])
+	})
+
+	t.Run("does not resend transmissions of a dropped batch", func(t *testing.T) {
+		batcher, txManager := setup(t, func(*solana.Transaction) interface{} { return nil })
+
+		stateA, stateB := solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey()
+		transmit(t, batcher, map[solana.PublicKey]solana.Instruction{
+			stateA: instruction(stateA, 100),
+			stateB: instruction(stateB, 100),
+		})
+		require.Len(t, txManager.txs, 1)
+
+		// dropped batches are not resent, the callback returns without sending
+		txManager.cfgs[0].OnError(txm.TxFailDrop)
+		batcher.wg.Wait()
+		assert.Len(t, txManager.txs, 1)
+	})
+
+	t.Run("splits transmissions that do not fit", func(t *testing.T) {
+		batcher, txManager := setup(t, func(*solana.Transaction) interface{} { return nil })
+
+		stateA, stateB := solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey()
+		errs := transmit(t, batcher, map[solana.PublicKey]solana.Instruction{
+			stateA: instruction(stateA, 700),
+			stateB: instruction(stateB, 700),
+		})
+		assert.NoError(t, errs[stateA])
+		assert.NoError(t, errs[stateB])
+
+		require.Len(t, txManager.txs, 2)
+		for _, tx := range txManager.txs {
+			assert.Len(t, tx.Message.Instructions, 1)
+		}
+	})
+
+	t.Run("splits out failing transmissions", func(t *testing.T) {
+		stateA, stateB, stateC := solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey()
+		batcher, txManager := setup(t, func(tx *solana.Transaction) interface{} {
+			// the transmission of stateB fails
+			for i, instruction := range tx.Message.Instructions {
+				if tx.Message.AccountKeys[instruction.Accounts[0]] == stateB {
+					return map[string]interface{}{"InstructionError": []interface{}{float64(i), map[string]interface{}{"Custom": float64(1)}}}
+				}
+			}
+			return nil
+		})
+
+		errs := transmit(t, batcher, map[solana.PublicKey]solana.Instruction{
+			stateA: instruction(stateA, 100),
+			stateB: instruction(stateB, 100),
+			stateC: instruction(stateC, 100),
+		})
+		assert.NoError(t, errs[stateA])
+		assert.NoError(t, errs[stateB])
+		assert.NoError(t, errs[stateC])
+
+		require.Len(t, txManager.txs, 2)
+		assert.Len(t, txManager.txs[0].Message.Instructions, 1)
+		assert.Equal(t, stateB, txManager.txs[0].Message.AccountKeys[txManager.txs[0].Message.Instructions[0].Accounts[0]])
+		assert.Len(t, txManager.txs[1].Message.Instructions, 2)
+	})
+
+	t.Run("fails pending transmissions when stopped", func(t *testing.T) {
+		rw := clientmocks.NewReaderWriter(t)
+		batcher := NewTransmissionBatcher("test-chain-id", cfg, func() (client.ReaderWriter, error) { return rw, nil }, &enqueuedTxs{}, logger.Test(t))
+		require.NoError(t, batcher.Start(tests.Context(t)))
+
+		stateID := solana.NewWallet().PublicKey()
+		result := make(chan error)
+		go func() {
+			result <- batcher.Transmit(tests.Context(t), transmitter, stateID, instruction(stateID, 100), notSuperseded)
+		}()
+
+		require.Eventually(t, func() bool {
+			batcher.lock.Lock()
+			defer batcher.lock.Unlock()
+			return len(batcher.pending[transmitter]) == 1
+		}, tests.WaitTimeout(t), time.Millisecond)
+		require.NoError(t, batcher.Close())
+		assert.ErrorContains(t, <-result, "stopped")
+		assert.Error(t, batcher.Transmit(tests.Context(t), transmitter, stateID, instruction(stateID, 100), notSuperseded))
+	})
+}
+
+func TestInstructionErrorIndex(t *testing.T) {
+	index, ok := instructionErrorIndex(map[string]interface{}{"InstructionError": []interface{}{float64(2), "InvalidArgument"}})
+	assert.True(t, ok)
+	assert.Equal(t, 2, index)
+
+	_, ok = instructionErrorIndex("BlockhashNotFound")
+	assert.False(t, ok)
+	_, ok = instructionErrorIndex(nil)
+	assert.False(t, ok)
+}
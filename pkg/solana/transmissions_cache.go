@@ -19,12 +19,10 @@ type TransmissionsCache struct {
 	*client.Cache[Answer]
 }
 
-func NewTransmissionsCache(transmissionsID solana.PublicKey, chainID string, cfg config.Config, reader client.Reader, lggr logger.Logger) *TransmissionsCache {
+// NewSubscribedTransmissionsCache creates a transmissions cache that is updated by an account subscription.
+func NewSubscribedTransmissionsCache(transmissionsID solana.PublicKey, chainID string, cfg config.Config, subscriptions *client.AccountSubscriptions, lggr logger.Logger) *TransmissionsCache {
 	name := "ocr2_median_transmissions"
-	getter := func(ctx context.Context) (Answer, uint64, error) {
-		return GetLatestTransmission(ctx, reader, transmissionsID, cfg.Commitment())
-	}
-	return &TransmissionsCache{client.NewCache(name, transmissionsID, chainID, cfg, getter, logger.With(lggr, "cache", name))}
+	return &TransmissionsCache{client.NewSubscribedCache(name, transmissionsID, chainID, cfg, subscriptions, DecodeLatestTransmission, logger.With(lggr, "cache", name))}
 }
 
 func GetLatestTransmission(ctx context.Context, reader client.AccountReader, account solana.PublicKey, commitment rpc.CommitmentType) (Answer, uint64, error) {
@@ -38,6 +36,7 @@ func GetLatestTransmission(ctx context.Context, reader client.AccountReader, acc
 			Offset: &headerStart,
 			Length: &headerLen,
 		},
+		MinContextSlot: client.MinContextSlot(ctx),
 	})
 	if err != nil {
 		return Answer{}, 0, fmt.Errorf("error on rpc.GetAccountInfo [cursor]: %w", err)
@@ -48,36 +47,26 @@ func GetLatestTransmission(ctx context.Context, reader client.AccountReader, acc
 		return Answer{}, 0, errors.New("nil pointer returned in GetLatestTransmission.GetAccountInfoWithOpts.Header")
 	}
 
-	// parse header
-	var header TransmissionsHeader
-	if err = bin.NewBinDecoder(res.Value.Data.GetBinary()).Decode(&header); err != nil {
-		return Answer{}, 0, fmt.Errorf("failed to decode transmission account header: %w", err)
-	}
-
-	if header.Version != 2 {
-		return Answer{}, 0, fmt.Errorf("can't parse feed version %v: %w", header.Version, err)
+	header, err := decodeTransmissionsHeader(res.Value.Data.GetBinary())
+	if err != nil {
+		return Answer{}, 0, err
 	}
 
-	cursor := header.LiveCursor
-	liveLength := header.LiveLength
-
-	if cursor == 0 { // handle array wrap
-		cursor = liveLength
-	}
-	cursor-- // cursor indicates index for new answer, latest answer is in previous index
+	// the transmission must not be read from an older slot than the header
+	return getTransmission(ctx, reader, account, latestTransmissionOffset(header), commitment, res.RPCContext.Context.Slot)
+}
 
-	// setup transmissionLen
+// getTransmission reads the transmission at the offset of the account at minSlot or later.
+func getTransmission(ctx context.Context, reader client.AccountReader, account solana.PublicKey, offset uint64, commitment rpc.CommitmentType, minSlot uint64) (Answer, uint64, error) {
 	transmissionLen := TransmissionLen
-
-	transmissionOffset := AccountDiscriminatorLen + TransmissionsHeaderMaxSize + (uint64(cursor) * transmissionLen)
-
-	res, err = reader.GetAccountInfoWithOpts(ctx, account, &rpc.GetAccountInfoOpts{
+	res, err := reader.GetAccountInfoWithOpts(ctx, account, &rpc.GetAccountInfoOpts{
 		Encoding:   "base64",
 		Commitment: commitment,
 		DataSlice: &rpc.DataSlice{
-			Offset: &transmissionOffset,
+			Offset: &offset,
 			Length: &transmissionLen,
 		},
+		MinContextSlot: &minSlot,
 	})
 	if err != nil {
 		return Answer{}, 0, fmt.Errorf("error on rpc.GetAccountInfo [transmission]: %w", err)
@@ -87,14 +76,69 @@ func GetLatestTransmission(ctx context.Context, reader client.AccountReader, acc
 		return Answer{}, 0, errors.New("nil pointer returned in GetLatestTransmission.GetAccountInfoWithOpts.Transmission")
 	}
 
+	answer, err := decodeTransmission(res.Value.Data.GetBinary())
+	if err != nil {
+		return Answer{}, 0, err
+	}
+
+	return answer, res.RPCContext.Context.Slot, nil
+}
+
+// DecodeLatestTransmission decodes the latest answer from the full data of a transmissions account.
+func DecodeLatestTransmission(data []byte) (Answer, error) {
+	headerEnd := AccountDiscriminatorLen + TransmissionsHeaderLen
+	if uint64(len(data)) < headerEnd {
+		return Answer{}, fmt.Errorf("transmissions account data too short for header: %d bytes", len(data))
+	}
+
+	header, err := decodeTransmissionsHeader(data[AccountDiscriminatorLen:headerEnd])
+	if err != nil {
+		return Answer{}, err
+	}
+
+	offset := latestTransmissionOffset(header)
+	if uint64(len(data)) < offset+TransmissionLen {
+		return Answer{}, fmt.Errorf("transmissions account data too short for transmission at offset %d: %d bytes", offset, len(data))
+	}
+
+	return decodeTransmission(data[offset : offset+TransmissionLen])
+}
+
+func decodeTransmissionsHeader(data []byte) (TransmissionsHeader, error) {
+	var header TransmissionsHeader
+	if err := bin.NewBinDecoder(data).Decode(&header); err != nil {
+		return TransmissionsHeader{}, fmt.Errorf("failed to decode transmission account header: %w", err)
+	}
+
+	if header.Version != 2 {
+		return TransmissionsHeader{}, fmt.Errorf("can't parse feed version %v", header.Version)
+	}
+
+	return header, nil
+}
+
+// latestTransmissionOffset returns the offset of the latest transmission in the account data.
+func latestTransmissionOffset(header TransmissionsHeader) uint64 {
+	cursor := header.LiveCursor
+	liveLength := header.LiveLength
+
+	if cursor == 0 { // handle array wrap
+		cursor = liveLength
+	}
+	cursor-- // cursor indicates index for new answer, latest answer is in previous index
+
+	return AccountDiscriminatorLen + TransmissionsHeaderMaxSize + (uint64(cursor) * TransmissionLen)
+}
+
+func decodeTransmission(data []byte) (Answer, error) {
 	// parse tranmission
 	var t Transmission
-	if err := bin.NewBinDecoder(res.Value.Data.GetBinary()).Decode(&t); err != nil {
-		return Answer{}, 0, fmt.Errorf("failed to decode transmission: %w", err)
+	if err := bin.NewBinDecoder(data).Decode(&t); err != nil {
+		return Answer{}, fmt.Errorf("failed to decode transmission: %w", err)
 	}
 
 	return Answer{
 		Data:      t.Answer.BigInt(),
 		Timestamp: t.Timestamp,
-	}, res.RPCContext.Context.Slot, nil
+	}, nil
 }
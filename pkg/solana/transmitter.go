@@ -13,6 +13,8 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/utils"
 
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/monitor"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/txm"
 )
 
 var _ types.ContractTransmitter = (*Transmitter)(nil)
@@ -21,6 +23,11 @@ type Transmitter struct {
 	stateID, programID, storeProgramID, transmissionsID, transmissionSigner solana.PublicKey
 	reader                                                                  client.Reader
 	stateCache                                                              *StateCache
+	chainID                                                                 string
+	refreshState                                                            bool                 // refresh the state cache before checking if a report already landed
+	batcher                                                                 *TransmissionBatcher // optional, batches transmissions with other feeds
+	txConfigs                                                               []txm.SetTxConfig    // per-job overrides of the chain tx config
+	pool                                                                    *TransmitterPool     // optional, selects the fee payer of each transmission
 	lggr                                                                    logger.Logger
 	txManager                                                               TxManager
 }
@@ -32,6 +39,61 @@ func (c *Transmitter) Transmit(
 	report types.Report,
 	sigs []types.AttributedOnchainSignature,
 ) error {
+	// skip reports that another oracle already landed, the tx would fail on-chain
+	if c.superseded(ctx, reportCtx, c.refreshState) {
+		c.lggr.Debugw("Skipping transmit of superseded report", "epoch", reportCtx.Epoch, "round", reportCtx.Round)
+		monitor.IncSkippedTransmissions(c.chainID, c.stateID.String())
+		return nil
+	}
+
+	// stop retrying once the report is superseded, using the cached state only
+	superseded := func(ctx context.Context) bool {
+		return c.superseded(ctx, reportCtx, false)
+	}
+
+	// a batch is sent with a shared tx config and fee payer, so feeds with overrides or a pool are sent on their own
+	if c.batcher != nil && len(c.txConfigs) == 0 && c.pool == nil {
+		instruction, err := c.instruction(c.transmissionSigner, reportCtx, report, sigs)
+		if err != nil {
+			return err
+		}
+		c.lggr.Debugf("Batching transmit: state (%s) + transmissions (%s)", c.stateID.String(), c.transmissionsID.String())
+		if err = c.batcher.Transmit(ctx, c.transmissionSigner, c.stateID, instruction, superseded); err != nil {
+			return fmt.Errorf("error on Transmit.batcher.Transmit: %w", err)
+		}
+		return nil
+	}
+
+	txCfgs := append([]txm.SetTxConfig{txm.SetSuperseded(superseded)}, c.txConfigs...)
+	if c.pool == nil {
+		return c.enqueue(ctx, c.transmissionSigner, reportCtx, report, sigs, txCfgs)
+	}
+
+	signer, release, err := c.pool.Select()
+	if err != nil {
+		return fmt.Errorf("error on Transmit.pool.Select: %w", err)
+	}
+	if err = c.enqueue(ctx, signer, reportCtx, report, sigs, append(txCfgs, txm.SetOnDone(release))); err != nil {
+		release()
+		return err
+	}
+	return nil
+}
+
+// enqueue builds the transmit tx paid by the signer and passes it to the txm
+func (c *Transmitter) enqueue(
+	ctx context.Context,
+	signer solana.PublicKey,
+	reportCtx types.ReportContext,
+	report types.Report,
+	sigs []types.AttributedOnchainSignature,
+	txCfgs []txm.SetTxConfig,
+) error {
+	instruction, err := c.instruction(signer, reportCtx, report, sigs)
+	if err != nil {
+		return err
+	}
+
 	blockhash, err := c.reader.LatestBlockhash(ctx)
 	if err != nil {
 		return fmt.Errorf("error on Transmit.GetRecentBlockhash: %w", err)
@@ -40,17 +102,41 @@ func (c *Transmitter) Transmit(
 		return errors.New("nil pointer returned from Transmit.GetRecentBlockhash")
 	}
 
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{instruction},
+		blockhash.Value.Blockhash,
+		solana.TransactionPayer(signer),
+	)
+	if err != nil {
+		return fmt.Errorf("error on Transmit.NewTransaction: %w", err)
+	}
+
+	// pass transmit payload to tx manager queue
+	c.lggr.Debugf("Queuing transmit tx: state (%s) + transmissions (%s)", c.stateID.String(), c.transmissionsID.String())
+	if err = c.txManager.Enqueue(ctx, c.stateID.String(), tx, txCfgs...); err != nil {
+		return fmt.Errorf("error on Transmit.txManager.Enqueue: %w", err)
+	}
+	return nil
+}
+
+// instruction builds the transmit instruction of the report
+func (c *Transmitter) instruction(
+	signer solana.PublicKey,
+	reportCtx types.ReportContext,
+	report types.Report,
+	sigs []types.AttributedOnchainSignature,
+) (solana.Instruction, error) {
 	// Determine store authority
 	seeds := [][]byte{[]byte("store"), c.stateID.Bytes()}
 	storeAuthority, storeNonce, err := solana.FindProgramAddress(seeds, c.programID)
 	if err != nil {
-		return fmt.Errorf("error on Transmit.FindProgramAddress: %w", err)
+		return nil, fmt.Errorf("error on Transmit.FindProgramAddress: %w", err)
 	}
 
 	accounts := []*solana.AccountMeta{
 		// state, transmitter, transmissions, store_program, store, store_authority, instructions_sysvar
 		{PublicKey: c.stateID, IsWritable: true, IsSigner: false},
-		{PublicKey: c.transmissionSigner, IsWritable: false, IsSigner: true},
+		{PublicKey: signer, IsWritable: false, IsSigner: true},
 		{PublicKey: c.transmissionsID, IsWritable: true, IsSigner: false},
 		{PublicKey: c.storeProgramID, IsWritable: false, IsSigner: false},
 		{PublicKey: storeAuthority, IsWritable: false, IsSigner: false},
@@ -71,23 +157,34 @@ func (c *Transmitter) Transmit(
 		data.Write(sig.Signature)
 	}
 
-	tx, err := solana.NewTransaction(
-		[]solana.Instruction{
-			solana.NewInstruction(c.programID, accounts, data.Bytes()),
-		},
-		blockhash.Value.Blockhash,
-		solana.TransactionPayer(c.transmissionSigner),
-	)
+	return solana.NewInstruction(c.programID, accounts, data.Bytes()), nil
+}
+
+// superseded returns true if the on-chain state already has the same or a later epoch and round for
+// the config digest of the report. If the state can't be read the report is not superseded.
+func (c *Transmitter) superseded(ctx context.Context, reportCtx types.ReportContext, refresh bool) bool {
+	if refresh {
+		if err := c.stateCache.Fetch(ctx); err != nil {
+			c.lggr.Warnw("Failed to refresh state before transmit", "err", err)
+		}
+	}
+
+	state, err := c.stateCache.Read()
 	if err != nil {
-		return fmt.Errorf("error on Transmit.NewTransaction: %w", err)
+		return false
 	}
 
-	// pass transmit payload to tx manager queue
-	c.lggr.Debugf("Queuing transmit tx: state (%s) + transmissions (%s)", c.stateID.String(), c.transmissionsID.String())
-	if err = c.txManager.Enqueue(ctx, c.stateID.String(), tx); err != nil {
-		return fmt.Errorf("error on Transmit.txManager.Enqueue: %w", err)
+	return isSuperseded(state.Config, reportCtx)
+}
+
+func isSuperseded(config Config, reportCtx types.ReportContext) bool {
+	if config.LatestConfigDigest != reportCtx.ConfigDigest {
+		return false
 	}
-	return nil
+	if config.Epoch != reportCtx.Epoch {
+		return config.Epoch > reportCtx.Epoch
+	}
+	return config.Round >= reportCtx.Round
 }
 
 func (c *Transmitter) LatestConfigDigestAndEpoch(
@@ -101,6 +198,9 @@ func (c *Transmitter) LatestConfigDigestAndEpoch(
 	return state.Config.LatestConfigDigest, state.Config.Epoch, err
 }
 
+// FromAccount returns the job's transmitter key, which identifies the oracle in the contract config. With a
+// transmitter pool, transmissions are signed and paid by any pool key that is an oracle transmitter, so the
+// signer of a landed transmission can differ from this account and its rewards accrue to the oracle of the signer.
 func (c *Transmitter) FromAccount(ctx context.Context) (types.Account, error) {
 	return types.Account(c.transmissionSigner.String()), nil
 }
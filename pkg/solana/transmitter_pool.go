@@ -0,0 +1,213 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/monitor"
+)
+
+const (
+	// TransmitterSelectionRoundRobin rotates through the keys of the pool
+	TransmitterSelectionRoundRobin = "roundRobin"
+	// TransmitterSelectionLowestPending selects the key with the fewest txs still being broadcast
+	TransmitterSelectionLowestPending = "lowestPending"
+)
+
+// TransmitterPool selects the fee payer of each transmission from a pool of transmitter keys, so that the
+// balance and fee payer ordering of a single key do not limit the feed. Only keys registered as oracle
+// transmitters in the state account are selected. The balances of the keys are reported.
+type TransmitterPool struct {
+	services.StateMachine
+	chainID       string
+	stateID       solana.PublicKey
+	keys          []solana.PublicKey
+	lowestPending bool
+	stateCache    *StateCache
+	reader        client.Reader
+	pollPeriod    time.Duration
+	lggr          logger.Logger
+
+	lock    sync.Mutex
+	next    int                      // next key for round robin selection
+	pending map[solana.PublicKey]int // number of txs being broadcast by key
+
+	stopCh services.StopChan
+	done   chan struct{}
+}
+
+func NewTransmitterPool(chainID string, stateID solana.PublicKey, keys []solana.PublicKey, selection string, stateCache *StateCache, reader client.Reader, pollPeriod time.Duration, lggr logger.Logger) (*TransmitterPool, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("transmitter pool requires at least one key")
+	}
+
+	var lowestPending bool
+	switch selection {
+	case "", TransmitterSelectionRoundRobin:
+	case TransmitterSelectionLowestPending:
+		lowestPending = true
+	default:
+		return nil, fmt.Errorf("unknown transmitter selection: %s", selection)
+	}
+
+	return &TransmitterPool{
+		chainID:       chainID,
+		stateID:       stateID,
+		keys:          keys,
+		lowestPending: lowestPending,
+		stateCache:    stateCache,
+		reader:        reader,
+		pollPeriod:    pollPeriod,
+		lggr:          logger.Named(lggr, "TransmitterPool"),
+		pending:       map[solana.PublicKey]int{},
+		stopCh:        make(chan struct{}),
+		done:          make(chan struct{}),
+	}, nil
+}
+
+func (p *TransmitterPool) Name() string {
+	return p.lggr.Name()
+}
+
+func (p *TransmitterPool) Start(context.Context) error {
+	return p.StartOnce("TransmitterPool", func() error {
+		go p.monitorBalances()
+		return nil
+	})
+}
+
+func (p *TransmitterPool) Close() error {
+	return p.StopOnce("TransmitterPool", func() error {
+		close(p.stopCh)
+		<-p.done
+		return nil
+	})
+}
+
+// HealthReport reports a pool without any key registered as an oracle transmitter.
+func (p *TransmitterPool) HealthReport() map[string]error {
+	err := p.Healthy()
+	if err == nil {
+		err = p.checkAuthorized()
+	}
+	return map[string]error{p.Name(): err}
+}
+
+func (p *TransmitterPool) checkAuthorized() error {
+	authorized, err := p.authorized()
+	if err != nil {
+		return err
+	}
+	for _, key := range p.keys {
+		if authorized[key] {
+			return nil
+		}
+	}
+	return fmt.Errorf("no key of the transmitter pool is an oracle transmitter of state %s", p.stateID)
+}
+
+// Select returns the transmitter key of the next transmission. The returned release func must be called once
+// the tx is no longer broadcast.
+func (p *TransmitterPool) Select() (solana.PublicKey, func(), error) {
+	authorized, err := p.authorized()
+	if err != nil {
+		return solana.PublicKey{}, nil, err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	selected := -1
+	for i := range p.keys {
+		// start after the previously selected key, so keys with the same pending count are rotated
+		index := (p.next + i) % len(p.keys)
+		if !authorized[p.keys[index]] {
+			continue
+		}
+		if selected < 0 {
+			selected = index
+			if !p.lowestPending {
+				break
+			}
+		}
+		if p.pending[p.keys[index]] < p.pending[p.keys[selected]] {
+			selected = index
+		}
+	}
+	if selected < 0 {
+		return solana.PublicKey{}, nil, fmt.Errorf("no key of the transmitter pool is an oracle transmitter of state %s", p.stateID)
+	}
+
+	key := p.keys[selected]
+	p.next = selected + 1
+	p.pending[key]++
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			p.lock.Lock()
+			defer p.lock.Unlock()
+			p.pending[key]--
+		})
+	}
+	return key, release, nil
+}
+
+// authorized returns the keys registered as oracle transmitters in the state account
+func (p *TransmitterPool) authorized() (map[solana.PublicKey]bool, error) {
+	state, err := p.stateCache.Read()
+	if err != nil {
+		return nil, fmt.Errorf("error on TransmitterPool.stateCache.Read: %w", err)
+	}
+	oracles, err := state.Oracles.Data()
+	if err != nil {
+		return nil, err
+	}
+
+	transmitters := make(map[solana.PublicKey]bool, len(oracles))
+	for _, oracle := range oracles {
+		transmitters[oracle.Transmitter] = true
+	}
+	return transmitters, nil
+}
+
+func (p *TransmitterPool) monitorBalances() {
+	defer close(p.done)
+	ctx, cancel := p.stopCh.NewCtx()
+	defer cancel()
+
+	tick := time.After(0)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick:
+			p.updateBalances(ctx)
+			tick = time.After(utils.WithJitter(p.pollPeriod))
+		}
+	}
+}
+
+func (p *TransmitterPool) updateBalances(ctx context.Context) {
+	for _, key := range p.keys {
+		lamports, err := p.reader.Balance(ctx, key)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			p.lggr.Warnw("Failed to get balance of transmitter", "transmitter", key, "err", err)
+			continue
+		}
+		monitor.SetTransmitterPoolBalance(p.chainID, p.stateID.String(), key.String(), lamports)
+	}
+}
@@ -0,0 +1,156 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/smartcontractkit/libocr/offchainreporting2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	clientmocks "github.com/smartcontractkit/chainlink-solana/pkg/solana/client/mocks"
+)
+
+func TestTransmitterPool(t *testing.T) {
+	ctx := tests.Context(t)
+	keys := []solana.PublicKey{solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey()}
+
+	// the last key is not an oracle transmitter
+	var state State
+	state.Oracles.Len = 2
+	state.Oracles.Raw[0].Transmitter = keys[0]
+	state.Oracles.Raw[1].Transmitter = keys[1]
+
+	setup := func(t *testing.T, selection string) *TransmitterPool {
+		stateCache := testStateCache(t, func(context.Context) (State, uint64, error) { return state, 0, nil })
+		require.NoError(t, stateCache.Fetch(ctx))
+		pool, err := NewTransmitterPool("test-chain-id", solana.PublicKey{}, keys, selection, stateCache, nil, time.Minute, logger.Test(t))
+		require.NoError(t, err)
+		return pool
+	}
+
+	selectKey := func(t *testing.T, pool *TransmitterPool) (solana.PublicKey, func()) {
+		key, release, err := pool.Select()
+		require.NoError(t, err)
+		return key, release
+	}
+
+	t.Run("round robin", func(t *testing.T) {
+		pool := setup(t, TransmitterSelectionRoundRobin)
+		require.NoError(t, pool.checkAuthorized())
+		for i := 0; i < 4; i++ {
+			key, _ := selectKey(t, pool)
+			assert.Equal(t, keys[i%2], key)
+		}
+	})
+
+	t.Run("lowest pending", func(t *testing.T) {
+		pool := setup(t, TransmitterSelectionLowestPending)
+
+		key0, release0 := selectKey(t, pool)
+		assert.Equal(t, keys[0], key0)
+		key1, release1 := selectKey(t, pool)
+		assert.Equal(t, keys[1], key1)
+
+		// the first key has no pending txs once released
+		release0()
+		release0() // released once only
+		key, _ := selectKey(t, pool)
+		assert.Equal(t, keys[0], key)
+		key, release := selectKey(t, pool)
+		assert.Equal(t, keys[1], key)
+
+		release()
+		release1()
+		key, _ = selectKey(t, pool)
+		assert.Equal(t, keys[1], key)
+	})
+
+	t.Run("no authorized key", func(t *testing.T) {
+		stateCache := testStateCache(t, func(context.Context) (State, uint64, error) { return State{}, 0, nil })
+		pool, err := NewTransmitterPool("test-chain-id", solana.PublicKey{}, keys, "", stateCache, nil, time.Minute, logger.Test(t))
+		require.NoError(t, err)
+
+		// state not read yet
+		_, _, err = pool.Select()
+		require.Error(t, err)
+
+		require.NoError(t, stateCache.Fetch(ctx))
+		_, _, err = pool.Select()
+		require.ErrorContains(t, err, "no key of the transmitter pool")
+		require.ErrorContains(t, pool.checkAuthorized(), "no key of the transmitter pool")
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		_, err := NewTransmitterPool("test-chain-id", solana.PublicKey{}, nil, "", nil, nil, time.Minute, logger.Test(t))
+		require.Error(t, err)
+		_, err = NewTransmitterPool("test-chain-id", solana.PublicKey{}, keys, "random", nil, nil, time.Minute, logger.Test(t))
+		require.Error(t, err)
+	})
+
+	t.Run("balances", func(t *testing.T) {
+		reader := clientmocks.NewReaderWriter(t)
+		for _, key := range keys {
+			reader.On("Balance", mock.Anything, key).Return(uint64(solana.LAMPORTS_PER_SOL), nil).Once()
+		}
+		pool, err := NewTransmitterPool("test-chain-id", solana.PublicKey{}, keys, "", nil, reader, time.Minute, logger.Test(t))
+		require.NoError(t, err)
+		pool.updateBalances(ctx)
+	})
+
+	t.Run("balance errors", func(t *testing.T) {
+		reader := clientmocks.NewReaderWriter(t)
+		reader.On("Balance", mock.Anything, mock.Anything).Return(uint64(0), errors.New("rpc error")).Times(len(keys))
+		pool, err := NewTransmitterPool("test-chain-id", solana.PublicKey{}, keys, "", nil, reader, time.Minute, logger.Test(t))
+		require.NoError(t, err)
+		pool.updateBalances(ctx)
+	})
+}
+
+func TestTransmitter_Pool(t *testing.T) {
+	ctx := tests.Context(t)
+	keys := []solana.PublicKey{solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey()}
+
+	var state State
+	state.Oracles.Len = 1
+	state.Oracles.Raw[0].Transmitter = keys[1]
+	stateCache := testStateCache(t, func(context.Context) (State, uint64, error) { return state, 0, nil })
+	require.NoError(t, stateCache.Fetch(ctx))
+	pool, err := NewTransmitterPool("test-chain-id", solana.PublicKey{}, keys, TransmitterSelectionLowestPending, stateCache, nil, time.Minute, logger.Test(t))
+	require.NoError(t, err)
+
+	rw := clientmocks.NewReaderWriter(t)
+	rw.On("LatestBlockhash", mock.Anything).Return(&rpc.GetLatestBlockhashResult{
+		Value: &rpc.LatestBlockhashResult{},
+	}, nil).Once()
+
+	txManager := &enqueuedTxs{}
+	transmitter := Transmitter{
+		transmissionSigner: keys[0],
+		reader:             rw,
+		stateCache:         stateCache,
+		pool:               pool,
+		lggr:               logger.Test(t),
+		txManager:          txManager,
+	}
+
+	reportCtx := types.ReportContext{ReportTimestamp: types.ReportTimestamp{Epoch: 1}}
+	require.NoError(t, transmitter.Transmit(ctx, reportCtx, make([]byte, ReportLen), nil))
+	require.Len(t, txManager.txs, 1)
+	assert.Equal(t, keys[1], txManager.txs[0].Message.AccountKeys[0], "fee payer is the authorized key of the pool")
+
+	// the key is released once the txm is done with the tx
+	cfg := txManager.cfgs[0]
+	require.NotNil(t, cfg.OnDone)
+	assert.Equal(t, 1, pool.pending[keys[1]])
+	cfg.OnDone()
+	assert.Equal(t, 0, pool.pending[keys[1]])
+}
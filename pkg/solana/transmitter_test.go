@@ -2,7 +2,10 @@ package solana
 
 import (
 	"context"
+	"encoding/json"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/rpc"
@@ -14,7 +17,9 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
 
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/client"
 	clientmocks "github.com/smartcontractkit/chainlink-solana/pkg/solana/client/mocks"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/fees"
 	"github.com/smartcontractkit/chainlink-solana/pkg/solana/txm"
 )
@@ -42,6 +47,29 @@ func (txm verifyTxSize) Enqueue(_ context.Context, _ string, tx *solana.Transact
 	return nil
 }
 
+// enqueuedTxs records enqueued transactions and their tx configs
+type enqueuedTxs struct {
+	lock sync.Mutex
+	txs  []*solana.Transaction
+	cfgs []txm.TxConfig
+}
+
+func (e *enqueuedTxs) Enqueue(_ context.Context, _ string, tx *solana.Transaction, txCfgs ...txm.SetTxConfig) error {
+	var cfg txm.TxConfig
+	for _, v := range txCfgs {
+		v(&cfg)
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.txs = append(e.txs, tx)
+	e.cfgs = append(e.cfgs, cfg)
+	return nil
+}
+
+func testStateCache(t *testing.T, getter func(context.Context) (State, uint64, error)) *StateCache {
+	return &StateCache{client.NewCache("test", solana.PublicKey{}, "test-chain-id", config.NewDefault(), getter, logger.Test(t))}
+}
+
 func TestTransmitter_TxSize(t *testing.T) {
 	mustNewRandomPublicKey := func() solana.PublicKey {
 		k, err := solana.NewRandomPrivateKey()
@@ -69,7 +97,7 @@ func TestTransmitter_TxSize(t *testing.T) {
 		transmissionsID:    mustNewRandomPublicKey(),
 		transmissionSigner: signer.PublicKey(),
 		reader:             rw,
-		stateCache:         &StateCache{},
+		stateCache:         testStateCache(t, func(context.Context) (State, uint64, error) { return State{}, 0, nil }),
 		lggr:               logger.Test(t),
 		txManager:          mockTxm,
 	}
@@ -83,3 +111,132 @@ func TestTransmitter_TxSize(t *testing.T) {
 	}
 	require.NoError(t, transmitter.Transmit(tests.Context(t), types.ReportContext{}, make([]byte, ReportLen), sigs))
 }
+
+func TestTransmitter_Superseded(t *testing.T) {
+	ctx := tests.Context(t)
+	digest := types.ConfigDigest{1}
+
+	var state State
+	state.Config.LatestConfigDigest = digest
+	state.Config.Epoch = 2
+	state.Config.Round = 3
+	fetches := 0
+	stateCache := testStateCache(t, func(context.Context) (State, uint64, error) {
+		fetches++
+		return state, 0, nil
+	})
+
+	rw := clientmocks.NewReaderWriter(t)
+	rw.On("LatestBlockhash", mock.Anything).Return(&rpc.GetLatestBlockhashResult{
+		Value: &rpc.LatestBlockhashResult{},
+	}, nil).Maybe()
+
+	txManager := &enqueuedTxs{}
+	transmitter := Transmitter{
+		transmissionSigner: solana.NewWallet().PublicKey(),
+		reader:             rw,
+		stateCache:         stateCache,
+		lggr:               logger.Test(t),
+		txManager:          txManager,
+	}
+
+	reportCtx := func(digest types.ConfigDigest, epoch uint32, round uint8) types.ReportContext {
+		return types.ReportContext{ReportTimestamp: types.ReportTimestamp{ConfigDigest: digest, Epoch: epoch, Round: round}}
+	}
+
+	// transmit if the state is unknown
+	require.NoError(t, transmitter.Transmit(ctx, reportCtx(digest, 2, 3), make([]byte, ReportLen), nil))
+	require.Len(t, txManager.cfgs, 1)
+
+	require.NoError(t, stateCache.Fetch(ctx))
+	for _, tc := range []struct {
+		name       string
+		reportCtx  types.ReportContext
+		superseded bool
+	}{
+		{name: "same round", reportCtx: reportCtx(digest, 2, 3), superseded: true},
+		{name: "earlier round", reportCtx: reportCtx(digest, 2, 2), superseded: true},
+		{name: "earlier epoch", reportCtx: reportCtx(digest, 1, 4), superseded: true},
+		{name: "later round", reportCtx: reportCtx(digest, 2, 4)},
+		{name: "later epoch", reportCtx: reportCtx(digest, 3, 0)},
+		{name: "other config", reportCtx: reportCtx(types.ConfigDigest{2}, 1, 1)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			enqueued := len(txManager.cfgs)
+			require.NoError(t, transmitter.Transmit(ctx, tc.reportCtx, make([]byte, ReportLen), nil))
+			if tc.superseded {
+				assert.Len(t, txManager.cfgs, enqueued)
+				return
+			}
+
+			require.Len(t, txManager.cfgs, enqueued+1)
+			cfg := txManager.cfgs[enqueued]
+			require.NotNil(t, cfg.Superseded)
+			assert.False(t, cfg.Superseded(ctx))
+		})
+	}
+
+	// retries are cancelled once the round lands
+	require.NoError(t, transmitter.Transmit(ctx, reportCtx(digest, 3, 0), make([]byte, ReportLen), nil))
+	cfg := txManager.cfgs[len(txManager.cfgs)-1]
+	assert.False(t, cfg.Superseded(ctx))
+	state.Config.Epoch = 3
+	require.NoError(t, stateCache.Fetch(ctx))
+	assert.True(t, cfg.Superseded(ctx))
+
+	// the state is only refreshed before transmitting if enabled
+	fetched := fetches
+	state.Config.Epoch = 4
+	require.NoError(t, transmitter.Transmit(ctx, reportCtx(digest, 4, 0), make([]byte, ReportLen), nil))
+	assert.Equal(t, fetched, fetches)
+
+	transmitter.refreshState = true
+	enqueued := len(txManager.cfgs)
+	require.NoError(t, transmitter.Transmit(ctx, reportCtx(digest, 4, 0), make([]byte, ReportLen), nil))
+	assert.Equal(t, fetched+1, fetches)
+	assert.Len(t, txManager.cfgs, enqueued)
+}
+
+func TestTransmitter_TxConfigs(t *testing.T) {
+	ctx := tests.Context(t)
+
+	var relayConfig RelayConfig
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"computeUnitPriceMax": 100,
+		"baseComputeUnitPrice": 10,
+		"computeUnitLimit": 200000,
+		"estimateComputeUnitLimit": false,
+		"feeBumpPeriod": "5s"
+	}`), &relayConfig))
+
+	rw := clientmocks.NewReaderWriter(t)
+	rw.On("LatestBlockhash", mock.Anything).Return(&rpc.GetLatestBlockhashResult{
+		Value: &rpc.LatestBlockhashResult{},
+	}, nil).Once()
+
+	txManager := &enqueuedTxs{}
+	transmitter := Transmitter{
+		transmissionSigner: solana.NewWallet().PublicKey(),
+		reader:             rw,
+		stateCache:         testStateCache(t, nil),
+		// feeds with overrides are not batched, the batcher is not started
+		batcher:   NewTransmissionBatcher("test-chain-id", config.NewDefault(), nil, txManager, logger.Test(t)),
+		txConfigs: relayConfig.TxConfigs(),
+		lggr:      logger.Test(t),
+		txManager: txManager,
+	}
+
+	require.NoError(t, transmitter.Transmit(ctx, types.ReportContext{}, make([]byte, ReportLen), nil))
+	require.Len(t, txManager.cfgs, 1)
+	cfg := txManager.cfgs[0]
+	assert.NotNil(t, cfg.Superseded)
+	assert.Equal(t, uint64(100), cfg.ComputeUnitPriceMax)
+	assert.Equal(t, uint64(10), cfg.BaseComputeUnitPrice)
+	assert.Equal(t, uint32(200000), cfg.ComputeUnitLimit)
+	assert.Equal(t, 5*time.Second, cfg.FeeBumpPeriod)
+
+	// unset fields keep the chain config
+	assert.Zero(t, cfg.ComputeUnitPriceMin)
+	assert.Zero(t, cfg.Timeout)
+	assert.Empty(t, RelayConfig{}.TxConfigs())
+}
@@ -175,6 +175,7 @@ const (
 	TxFailDrop
 	TxFailSimRevert
 	TxFailSimOther
+	TxCancelSuperseded // not a failure, tx is no longer needed
 )
 
 func newPendingTxContextWithProm(id string) *pendingTxContextWithProm {
@@ -244,6 +245,9 @@ func (c *pendingTxContextWithProm) OnError(sig solana.Signature, errType int) uu
 	case TxFailSimOther:
 		promSolTxmSimOtherTxs.WithLabelValues(c.chainID).Add(1)
 		promSolTxmErrorTxs.WithLabelValues(c.chainID).Add(1)
+	case TxCancelSuperseded:
+		// superseded txs can be dropped before the initial broadcast, so there may be no tx for sig
+		promSolTxmSupersededTxs.WithLabelValues(c.chainID).Add(1)
 	}
 
 	return id
@@ -12,6 +12,12 @@ var (
 		Help: "Number of transactions that are included and successfully executed on chain",
 	}, []string{"chainID"})
 
+	// cancelled transactions
+	promSolTxmSupersededTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "solana_txm_tx_cancel_superseded",
+		Help: "Number of transactions that were dropped or stopped retrying because they were superseded. Note: an already broadcast tx may still be included onchain",
+	}, []string{"chainID"})
+
 	// inflight transactions
 	promSolTxmPendingTxs = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "solana_txm_tx_pending",
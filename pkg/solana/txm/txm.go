@@ -56,6 +56,8 @@ type Txm struct {
 	ks     SimpleKeystore
 	client *utils.LazyLoad[client.ReaderWriter]
 	fee    fees.Estimator
+
+	onError sync.Map // tx id -> TxConfig.OnError
 }
 
 type TxConfig struct {
@@ -69,6 +71,21 @@ type TxConfig struct {
 
 	EstimateComputeUnitLimit bool   // enable compute limit estimations using simulation
 	ComputeUnitLimit         uint32 // compute unit limit
+
+	// optional check if the tx is no longer needed, e.g. another tx already landed the same data
+	// superseded txs are dropped before the initial broadcast and cancelled while retrying
+	Superseded func(ctx context.Context) bool
+	// optional callback once the tx is no longer broadcast: dropped, failed to send or retries stopped
+	OnDone func()
+	// optional callback if the tx is dropped as superseded, or fails or is cancelled after the initial broadcast,
+	// with the TxFail* or TxCancelSuperseded error type
+	OnError func(errType int)
+}
+
+func (cfg TxConfig) done() {
+	if cfg.OnDone != nil {
+		cfg.OnDone()
+	}
 }
 
 type pendingTx struct {
@@ -131,12 +148,24 @@ func (txm *Txm) run() {
 	for {
 		select {
 		case msg := <-txm.chSend:
+			// drop tx if superseded while queued
+			if msg.cfg.Superseded != nil && msg.cfg.Superseded(ctx) {
+				txm.txs.OnError(solanaGo.Signature{}, TxCancelSuperseded) // increment cancelled metric
+				txm.lggr.Debugw("dropped superseded transaction before broadcast", "tx", msg)
+				if msg.cfg.OnError != nil {
+					msg.cfg.OnError(TxCancelSuperseded)
+				}
+				msg.cfg.done()
+				continue
+			}
+
 			// process tx (pass tx copy)
 			tx, id, sig, err := txm.sendWithRetry(ctx, *msg.tx, msg.cfg)
 			if err != nil {
 				txm.lggr.Errorw("failed to send transaction", "error", err)
 				txm.client.Reset() // clear client if tx fails immediately (potentially bad RPC)
-				continue           // skip remainining
+				msg.cfg.done()
+				continue // skip remainining
 			}
 
 			// send tx + signature to simulation queue
@@ -235,6 +264,9 @@ func (txm *Txm) sendWithRetry(ctx context.Context, baseTx solanaGo.Transaction,
 		cancel() // cancel context when exiting early
 		return solanaGo.Transaction{}, uuid.Nil, solanaGo.Signature{}, fmt.Errorf("failed to save tx signature (%s) to inflight txs: %w", sig, initStoreErr)
 	}
+	if txcfg.OnError != nil {
+		txm.onError.Store(id, txcfg.OnError)
+	}
 
 	// used for tracking rebroadcasting only in SendWithRetry
 	var sigs signatureList
@@ -251,6 +283,7 @@ func (txm *Txm) sendWithRetry(ctx context.Context, baseTx solanaGo.Transaction,
 	// pass in copy of baseTx (used to build new tx with bumped fee) and broadcasted tx == initTx (used to retry tx without bumping)
 	go func(ctx context.Context, baseTx, currentTx solanaGo.Transaction) {
 		defer txm.done.Done()
+		defer txcfg.done()
 		deltaT := 1 // ms
 		tick := time.After(0)
 		bumpCount := 0
@@ -265,6 +298,24 @@ func (txm *Txm) sendWithRetry(ctx context.Context, baseTx solanaGo.Transaction,
 				txm.lggr.Debugw("stopped tx retry", "id", id, "signatures", sigs.List(), "err", context.Cause(ctx))
 				return
 			case <-tick:
+				// cancel retries if the tx is no longer needed (stops confirmation polling for tx)
+				if txcfg.Superseded != nil && txcfg.Superseded(ctx) {
+					// the tx itself may have superseded the report, leave it to the confirmer then
+					isLanded, landedErr := landed(ctx, client, sigs.List())
+					if landedErr != nil {
+						txm.lggr.Warnw("failed to fetch signature statuses of superseded tx", "error", landedErr, "id", id)
+					} else if isLanded {
+						wg.Wait()
+						txm.lggr.Debugw("stopped tx retry, tx landed", "id", id, "signatures", sigs.List())
+						return
+					} else {
+						txm.fail(sig, TxCancelSuperseded) // cancels ctx
+						wg.Wait()
+						txm.lggr.Debugw("cancelled superseded tx", "id", id, "signatures", sigs.List())
+						return
+					}
+				}
+
 				var shouldBump bool
 				// bump if period > 0 and past time
 				if txcfg.FeeBumpPeriod != 0 && time.Since(bumpTime) > txcfg.FeeBumpPeriod {
@@ -349,6 +400,39 @@ func (txm *Txm) sendWithRetry(ctx context.Context, baseTx solanaGo.Transaction,
 	return initTx, id, sig, nil
 }
 
+// succeed marks the tx of the signature as confirmed
+func (txm *Txm) succeed(sig solanaGo.Signature) uuid.UUID {
+	id := txm.txs.OnSuccess(sig)
+	txm.onError.Delete(id)
+	return id
+}
+
+// fail marks the tx of the signature as failed and notifies its OnError callback
+func (txm *Txm) fail(sig solanaGo.Signature, errType int) uuid.UUID {
+	id := txm.txs.OnError(sig, errType)
+	if id == uuid.Nil {
+		return id // already removed
+	}
+	if fn, ok := txm.onError.LoadAndDelete(id); ok {
+		fn.(func(int))(errType)
+	}
+	return id
+}
+
+// landed returns true if any of the tx signatures is included in a block without an error
+func landed(ctx context.Context, reader client.Writer, sigs []solanaGo.Signature) (bool, error) {
+	res, err := reader.SignatureStatuses(ctx, sigs)
+	if err != nil {
+		return false, err
+	}
+	for _, status := range res {
+		if status != nil && status.Err == nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // goroutine that polls to confirm implementation
 // cancels the exponential retry once confirmed
 func (txm *Txm) confirm() {
@@ -403,7 +487,7 @@ func (txm *Txm) confirm() {
 
 						// check confirm timeout exceeded
 						if txm.txs.Expired(s[i], txm.cfg.TxConfirmTimeout()) {
-							id := txm.txs.OnError(s[i], TxFailDrop)
+							id := txm.fail(s[i], TxFailDrop)
 							txm.lggr.Infow("failed to find transaction within confirm timeout", "id", id, "signature", s[i], "timeoutSeconds", txm.cfg.TxConfirmTimeout())
 						}
 						continue
@@ -411,7 +495,7 @@ func (txm *Txm) confirm() {
 
 					// if signature has an error, end polling
 					if res[i].Err != nil {
-						id := txm.txs.OnError(s[i], TxFailRevert)
+						id := txm.fail(s[i], TxFailRevert)
 						txm.lggr.Debugw("tx state: failed",
 							"id", id,
 							"signature", s[i],
@@ -429,7 +513,7 @@ func (txm *Txm) confirm() {
 
 						// check confirm timeout exceeded
 						if txm.txs.Expired(s[i], txm.cfg.TxConfirmTimeout()) {
-							id := txm.txs.OnError(s[i], TxFailDrop)
+							id := txm.fail(s[i], TxFailDrop)
 							txm.lggr.Debugw("tx failed to move beyond 'processed' within confirm timeout", "id", id, "signature", s[i], "timeoutSeconds", txm.cfg.TxConfirmTimeout())
 						}
 						continue
@@ -437,7 +521,7 @@ func (txm *Txm) confirm() {
 
 					// if signature is confirmed/finalized, end polling
 					if res[i].ConfirmationStatus == rpc.ConfirmationStatusConfirmed || res[i].ConfirmationStatus == rpc.ConfirmationStatusFinalized {
-						id := txm.txs.OnSuccess(s[i])
+						id := txm.succeed(s[i])
 						txm.lggr.Debugw(fmt.Sprintf("tx state: %s", res[i].ConfirmationStatus),
 							"id", id,
 							"signature", s[i],
@@ -623,14 +707,14 @@ func (txm *Txm) processSimulationError(id uuid.UUID, sig solanaGo.Signature, res
 			txm.lggr.Debugw("simulate: BlockhashNotFound", "id", id, "signature", sig, "result", res)
 		// transaction will encounter execution error/revert, mark as reverted to remove from confirmation + retry
 		case strings.Contains(errStr, "InstructionError"):
-			txm.txs.OnError(sig, TxFailSimRevert) // cancel retry
+			txm.fail(sig, TxFailSimRevert) // cancel retry
 			txm.lggr.Debugw("simulate: InstructionError", "id", id, "signature", sig, "result", res)
 		// transaction is already processed in the chain, letting txm confirmation handle
 		case strings.Contains(errStr, "AlreadyProcessed"):
 			txm.lggr.Debugw("simulate: AlreadyProcessed", "id", id, "signature", sig, "result", res)
 		// unrecognized errors (indicates more concerning failures)
 		default:
-			txm.txs.OnError(sig, TxFailSimOther) // cancel retry
+			txm.fail(sig, TxFailSimOther) // cancel retry
 			txm.lggr.Errorw("simulate: unrecognized error", "id", id, "signature", sig, "result", res)
 		}
 	}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"math/rand"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -31,8 +32,8 @@ import (
 )
 
 type soltxmProm struct {
-	id                                                        string
-	success, error, revert, reject, drop, simRevert, simOther float64
+	id                                                                    string
+	success, error, revert, reject, drop, simRevert, simOther, superseded float64
 }
 
 func (p soltxmProm) assertEqual(t *testing.T) {
@@ -43,6 +44,7 @@ func (p soltxmProm) assertEqual(t *testing.T) {
 	assert.Equal(t, p.drop, testutil.ToFloat64(promSolTxmDropTxs.WithLabelValues(p.id)), "mismatch: drop")
 	assert.Equal(t, p.simRevert, testutil.ToFloat64(promSolTxmSimRevertTxs.WithLabelValues(p.id)), "mismatch: simRevert")
 	assert.Equal(t, p.simOther, testutil.ToFloat64(promSolTxmSimOtherTxs.WithLabelValues(p.id)), "mismatch: simOther")
+	assert.Equal(t, p.superseded, testutil.ToFloat64(promSolTxmSupersededTxs.WithLabelValues(p.id)), "mismatch: superseded")
 }
 
 func (p soltxmProm) getInflight() float64 {
@@ -525,10 +527,14 @@ func TestTxm(t *testing.T) {
 					}
 				}
 
+				errType := make(chan int, 1)
+				onError := func(e int) { errType <- e }
+
 				// tx should be able to queue
-				assert.NoError(t, txm.Enqueue(ctx, t.Name(), tx))
+				assert.NoError(t, txm.Enqueue(ctx, t.Name(), tx, SetOnError(onError)))
 				wg.Wait()      // wait to be picked up and processed
 				waitFor(empty) // inflight txs cleared after timeout
+				assert.Equal(t, TxFailRevert, <-errType)
 
 				// check prom metric
 				prom.error++
@@ -670,6 +676,113 @@ func TestTxm(t *testing.T) {
 				prom.success++
 				prom.assertEqual(t)
 			})
+
+			// tx superseded before initial broadcast
+			t.Run("superseded_beforeBroadcast", func(t *testing.T) {
+				tx, signed := getTx(t, 13, mkey, 0)
+
+				// should never be sent
+				mc.On("SendTx", mock.Anything, signed(0, true)).Panic("SendTx should not be called").Maybe()
+
+				var wg sync.WaitGroup
+				wg.Add(1)
+				superseded := func(context.Context) bool {
+					defer wg.Done()
+					return true
+				}
+
+				wg.Add(1) // done once dropped
+				onDone := func() { wg.Done() }
+				errType := make(chan int, 1)
+				onError := func(e int) { errType <- e }
+
+				// tx should be able to queue
+				assert.NoError(t, txm.Enqueue(ctx, t.Name(), tx, SetSuperseded(superseded), SetOnDone(onDone), SetOnError(onError)))
+				wg.Wait() // wait to be picked up and dropped
+				assert.Equal(t, TxCancelSuperseded, <-errType)
+
+				// check prom metric
+				prom.superseded++
+				require.Eventually(t, func() bool {
+					return testutil.ToFloat64(promSolTxmSupersededTxs.WithLabelValues(id)) == prom.superseded
+				}, tests.WaitTimeout(t), 10*time.Millisecond)
+				prom.assertEqual(t)
+			})
+
+			// tx superseded while retrying
+			t.Run("superseded_retryTx", func(t *testing.T) {
+				sig := getSig()
+				tx, signed := getTx(t, 14, mkey, 0)
+
+				var wg sync.WaitGroup
+				wg.Add(1)
+				var superseded atomic.Bool
+				mc.On("SendTx", mock.Anything, signed(0, true)).Run(func(mock.Arguments) {
+					// superseded by another tx after the first broadcast
+					if superseded.CompareAndSwap(false, true) {
+						wg.Done()
+					}
+				}).Return(sig, nil)
+				mc.On("SimulateTx", mock.Anything, signed(0, true), mock.Anything).Return(&rpc.SimulateTransactionResult{}, nil).Maybe()
+				// signature status is nil (handled automatically)
+
+				var done atomic.Bool
+				onDone := func() { done.Store(true) }
+				errType := make(chan int, 1)
+				onError := func(e int) { errType <- e }
+
+				// tx should be able to queue
+				assert.NoError(t, txm.Enqueue(ctx, t.Name(), tx, SetFeeBumpPeriod(0), SetSuperseded(func(context.Context) bool {
+					return superseded.Load()
+				}), SetOnDone(onDone), SetOnError(onError)))
+				wg.Wait()      // wait to be broadcast
+				waitFor(empty) // txs cleared once cancelled
+				require.Eventually(t, done.Load, tests.WaitTimeout(t), 10*time.Millisecond)
+				assert.Equal(t, TxCancelSuperseded, <-errType)
+
+				// check prom metric
+				prom.superseded++
+				prom.assertEqual(t)
+			})
+
+			// tx superseded by itself while retrying
+			t.Run("superseded_landedTx", func(t *testing.T) {
+				sig := getSig()
+				tx, signed := getTx(t, 15, mkey, 0)
+
+				var wg sync.WaitGroup
+				wg.Add(1)
+				var superseded atomic.Bool
+				mc.On("SendTx", mock.Anything, signed(0, true)).Run(func(mock.Arguments) {
+					// the tx lands after the first broadcast
+					if superseded.CompareAndSwap(false, true) {
+						wg.Done()
+					}
+				}).Return(sig, nil)
+				mc.On("SimulateTx", mock.Anything, signed(0, true), mock.Anything).Return(&rpc.SimulateTransactionResult{}, nil).Maybe()
+				statuses[sig] = func() *rpc.SignatureStatusesResult {
+					if !superseded.Load() {
+						return nil
+					}
+					return &rpc.SignatureStatusesResult{ConfirmationStatus: rpc.ConfirmationStatusConfirmed}
+				}
+
+				var done atomic.Bool
+				onDone := func() { done.Store(true) }
+				onError := func(int) { assert.Fail(t, "landed tx should not fail") }
+
+				// tx should be able to queue
+				assert.NoError(t, txm.Enqueue(ctx, t.Name(), tx, SetFeeBumpPeriod(0), SetSuperseded(func(context.Context) bool {
+					return superseded.Load()
+				}), SetOnDone(onDone), SetOnError(onError)))
+				wg.Wait()      // wait to be broadcast
+				waitFor(empty) // txs cleared once confirmed
+				require.Eventually(t, done.Load, tests.WaitTimeout(t), 10*time.Millisecond)
+
+				// check prom metric, the landed tx is not cancelled
+				prom.success++
+				prom.assertEqual(t)
+			})
 		})
 	}
 }
@@ -1,6 +1,7 @@
 package txm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sort"
@@ -184,3 +185,18 @@ func SetEstimateComputeUnitLimit(v bool) SetTxConfig {
 		cfg.EstimateComputeUnitLimit = v
 	}
 }
+func SetSuperseded(fn func(ctx context.Context) bool) SetTxConfig {
+	return func(cfg *TxConfig) {
+		cfg.Superseded = fn
+	}
+}
+func SetOnDone(fn func()) SetTxConfig {
+	return func(cfg *TxConfig) {
+		cfg.OnDone = fn
+	}
+}
+func SetOnError(fn func(errType int)) SetTxConfig {
+	return func(cfg *TxConfig) {
+		cfg.OnError = fn
+	}
+}
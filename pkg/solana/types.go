@@ -2,10 +2,16 @@ package solana
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 
 	bin "github.com/gagliardetto/binary"
 	"github.com/gagliardetto/solana-go"
+
+	relayconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/txm"
 )
 
 const (
@@ -18,6 +24,9 @@ const (
 	TransmissionsHeaderLen     uint64 = 1 + 1 + 32 + 32 + 32 + 32 + 1 + 4 + 4 + 1 + 4 + 4 + 4
 	TransmissionsHeaderMaxSize uint64 = 192 // max area allocated to transmissions header
 
+	// StateLen is the length of the encoded State, including the account discriminator
+	StateLen uint64 = 6920
+
 	// ReportLen data (61 bytes)
 	MedianLen       uint64 = 16
 	JuelsLen        uint64 = 8
@@ -176,4 +185,71 @@ type RelayConfig struct {
 	OCR2ProgramID   string `json:"ocr2ProgramID"`
 	TransmissionsID string `json:"transmissionsID"`
 	StoreProgramID  string `json:"storeProgramID"`
+
+	// refresh the state account before transmitting to skip reports that already landed
+	// otherwise the cached state is used
+	RefreshStateBeforeTransmit bool `json:"refreshStateBeforeTransmit"`
+
+	// optional overrides of the chain tx config for the transmissions of this job
+	ComputeUnitPriceMax      *uint64               `json:"computeUnitPriceMax,omitempty"`
+	ComputeUnitPriceMin      *uint64               `json:"computeUnitPriceMin,omitempty"`
+	BaseComputeUnitPrice     *uint64               `json:"baseComputeUnitPrice,omitempty"` // starting priority fee
+	ComputeUnitLimit         *uint32               `json:"computeUnitLimit,omitempty"`
+	EstimateComputeUnitLimit *bool                 `json:"estimateComputeUnitLimit,omitempty"`
+	FeeBumpPeriod            *relayconfig.Duration `json:"feeBumpPeriod,omitempty"`
+	TxRetryTimeout           *relayconfig.Duration `json:"txRetryTimeout,omitempty"`
+
+	// optional pool of transmitter keys used in addition to the job's transmitter key
+	TransmitterIDs       []string `json:"transmitterIDs,omitempty"`
+	TransmitterSelection string   `json:"transmitterSelection,omitempty"` // roundRobin (default) or lowestPending
+
+	// program and packing of OCR3 reports, required for OCR3 capabilities
+	OCR3Transmitter *OCR3TransmitterConfig `json:"ocr3Transmitter,omitempty"`
+	// program and packing of OCR2 reports of generic plugins, configured like OCR3Transmitter
+	PluginTransmitter *OCR3TransmitterConfig `json:"pluginTransmitter,omitempty"`
+}
+
+// ValidateTxConfigs checks the tx config overrides of the job, unset compute unit prices fall back to the chain config
+func (r RelayConfig) ValidateTxConfigs(cfg config.Config) (err error) {
+	priceMax, priceMin := cfg.ComputeUnitPriceMax(), cfg.ComputeUnitPriceMin()
+	if r.ComputeUnitPriceMax != nil {
+		priceMax = *r.ComputeUnitPriceMax
+	}
+	if r.ComputeUnitPriceMin != nil {
+		priceMin = *r.ComputeUnitPriceMin
+	}
+	if (r.ComputeUnitPriceMax != nil || r.ComputeUnitPriceMin != nil) && priceMin > priceMax {
+		err = errors.Join(err, fmt.Errorf("computeUnitPriceMin %d is greater than computeUnitPriceMax %d", priceMin, priceMax))
+	}
+	if r.ComputeUnitLimit != nil && (*r.ComputeUnitLimit == 0 || *r.ComputeUnitLimit > maxComputeUnitLimit) {
+		err = errors.Join(err, fmt.Errorf("computeUnitLimit %d must be between 1 and %d", *r.ComputeUnitLimit, maxComputeUnitLimit))
+	}
+	return
+}
+
+// TxConfigs returns the tx config overrides of the job
+func (r RelayConfig) TxConfigs() []txm.SetTxConfig {
+	var txCfgs []txm.SetTxConfig
+	if r.ComputeUnitPriceMax != nil {
+		txCfgs = append(txCfgs, txm.SetComputeUnitPriceMax(*r.ComputeUnitPriceMax))
+	}
+	if r.ComputeUnitPriceMin != nil {
+		txCfgs = append(txCfgs, txm.SetComputeUnitPriceMin(*r.ComputeUnitPriceMin))
+	}
+	if r.BaseComputeUnitPrice != nil {
+		txCfgs = append(txCfgs, txm.SetBaseComputeUnitPrice(*r.BaseComputeUnitPrice))
+	}
+	if r.ComputeUnitLimit != nil {
+		txCfgs = append(txCfgs, txm.SetComputeUnitLimit(*r.ComputeUnitLimit))
+	}
+	if r.EstimateComputeUnitLimit != nil {
+		txCfgs = append(txCfgs, txm.SetEstimateComputeUnitLimit(*r.EstimateComputeUnitLimit))
+	}
+	if r.FeeBumpPeriod != nil {
+		txCfgs = append(txCfgs, txm.SetFeeBumpPeriod(r.FeeBumpPeriod.Duration()))
+	}
+	if r.TxRetryTimeout != nil {
+		txCfgs = append(txCfgs, txm.SetTimeout(r.TxRetryTimeout.Duration()))
+	}
+	return txCfgs
 }
@@ -3,11 +3,20 @@ package solana
 import (
 	"bytes"
 	"encoding/hex"
+	"encoding/json"
+	"math/big"
 	"testing"
 
 	bin "github.com/gagliardetto/binary"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/codec/encodings/binary"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/codec/testutils"
+	"github.com/smartcontractkit/chainlink-solana/pkg/solana/config"
 )
 
 func TestState_Decode(t *testing.T) {
@@ -39,6 +48,62 @@ func TestState_Decode(t *testing.T) {
 		offchainConfig))
 }
 
+func TestState_DecodeWithIDL(t *testing.T) {
+	var idl codec.IDL
+	require.NoError(t, json.Unmarshal([]byte(testutils.OCR2IDL), &idl))
+
+	stateCodec, err := codec.NewIDLZeroCopyAccountCodec(idl, binary.LittleEndian())
+	require.NoError(t, err)
+
+	var expected State
+	require.NoError(t, bin.NewBorshDecoder(mockState.Raw).Decode(&expected))
+
+	var decoded struct {
+		Version    uint8
+		VaultNonce uint8
+		Feed       [32]byte
+		Config     struct {
+			F                  uint8
+			MinAnswer          *big.Int
+			MaxAnswer          *big.Int
+			Epoch              uint32
+			LatestConfigDigest [32]byte
+		}
+		OffchainConfig struct {
+			Version uint64
+			Xs      []byte
+		}
+		Oracles struct {
+			Xs []struct {
+				Transmitter [32]byte
+				Signer      struct{ Key [20]byte }
+			}
+		}
+	}
+
+	require.NoError(t, stateCodec.Decode(tests.Context(t), mockState.Raw, &decoded, "State"))
+
+	assert.Equal(t, expected.Version, decoded.Version)
+	assert.Equal(t, expected.Nonce, decoded.VaultNonce)
+	assert.Equal(t, [32]byte(expected.Transmissions), decoded.Feed)
+	assert.Equal(t, expected.Config.F, decoded.Config.F)
+	assert.Equal(t, expected.Config.MinAnswer.BigInt(), decoded.Config.MinAnswer)
+	assert.Equal(t, expected.Config.MaxAnswer.BigInt(), decoded.Config.MaxAnswer)
+	assert.Equal(t, expected.Config.Epoch, decoded.Config.Epoch)
+	assert.Equal(t, expected.Config.LatestConfigDigest, decoded.Config.LatestConfigDigest)
+	assert.Equal(t, expected.OffchainConfig.Version, decoded.OffchainConfig.Version)
+	assert.Equal(t, []byte{4, 5, 6, 4, 5, 6}, decoded.OffchainConfig.Xs)
+
+	oracles, err := expected.Oracles.Data()
+	require.NoError(t, err)
+	require.Len(t, decoded.Oracles.Xs, len(oracles))
+
+	for i, oracle := range oracles {
+		assert.Equal(t, [32]byte(oracle.Transmitter), decoded.Oracles.Xs[i].Transmitter)
+		assert.Equal(t, oracle.Signer.Key, decoded.Oracles.Xs[i].Signer.Key)
+	}
+}
+
 func TestOffchainConfig_Data(t *testing.T) {
 	c := OffchainConfig{
 		Len: MaxOffchainConfigLen + 1,
@@ -657,3 +722,31 @@ var mockState = struct {
 		19, 0, 0, 0, 0, 0, 0, 0,
 	},
 }
+
+func TestRelayConfig_ValidateTxConfigs(t *testing.T) {
+	// chain defaults: ComputeUnitPriceMin 0, ComputeUnitPriceMax 1000
+	cfg := config.NewDefault()
+	for _, tc := range []struct {
+		name        string
+		relayConfig string
+		err         string
+	}{
+		{"no overrides", `{}`, ""},
+		{"valid overrides", `{"computeUnitPriceMin": 10, "computeUnitPriceMax": 100, "computeUnitLimit": 1400000}`, ""},
+		{"min greater than max", `{"computeUnitPriceMin": 100, "computeUnitPriceMax": 10}`, "computeUnitPriceMin 100 is greater than computeUnitPriceMax 10"},
+		{"min greater than chain max", `{"computeUnitPriceMin": 1001}`, "computeUnitPriceMin 1001 is greater than computeUnitPriceMax 1000"},
+		{"zero compute unit limit", `{"computeUnitLimit": 0}`, "computeUnitLimit 0 must be between 1 and 1400000"},
+		{"compute unit limit above max", `{"computeUnitLimit": 1400001}`, "computeUnitLimit 1400001 must be between 1 and 1400000"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var relayConfig RelayConfig
+			require.NoError(t, json.Unmarshal([]byte(tc.relayConfig), &relayConfig))
+			err := relayConfig.ValidateTxConfigs(cfg)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}